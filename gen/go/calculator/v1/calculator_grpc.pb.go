@@ -19,7 +19,24 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AdditionService_Add_FullMethodName = "/calculator.v1.AdditionService/Add"
+	AdditionService_Add_FullMethodName               = "/calculator.v1.AdditionService/Add"
+	AdditionService_BatchAdd_FullMethodName          = "/calculator.v1.AdditionService/BatchAdd"
+	AdditionService_AddVectors_FullMethodName        = "/calculator.v1.AdditionService/AddVectors"
+	AdditionService_AddMatrices_FullMethodName       = "/calculator.v1.AdditionService/AddMatrices"
+	AdditionService_AddStream_FullMethodName         = "/calculator.v1.AdditionService/AddStream"
+	AdditionService_AddProgress_FullMethodName       = "/calculator.v1.AdditionService/AddProgress"
+	AdditionService_Session_FullMethodName           = "/calculator.v1.AdditionService/Session"
+	AdditionService_Subtract_FullMethodName          = "/calculator.v1.AdditionService/Subtract"
+	AdditionService_Multiply_FullMethodName          = "/calculator.v1.AdditionService/Multiply"
+	AdditionService_Divide_FullMethodName            = "/calculator.v1.AdditionService/Divide"
+	AdditionService_Evaluate_FullMethodName          = "/calculator.v1.AdditionService/Evaluate"
+	AdditionService_Describe_FullMethodName          = "/calculator.v1.AdditionService/Describe"
+	AdditionService_ListCalculations_FullMethodName  = "/calculator.v1.AdditionService/ListCalculations"
+	AdditionService_GetCalculation_FullMethodName    = "/calculator.v1.AdditionService/GetCalculation"
+	AdditionService_SubmitCalculation_FullMethodName = "/calculator.v1.AdditionService/SubmitCalculation"
+	AdditionService_GetJob_FullMethodName            = "/calculator.v1.AdditionService/GetJob"
+	AdditionService_CancelJob_FullMethodName         = "/calculator.v1.AdditionService/CancelJob"
+	AdditionService_WaitJob_FullMethodName           = "/calculator.v1.AdditionService/WaitJob"
 )
 
 // AdditionServiceClient is the client API for AdditionService service.
@@ -28,6 +45,41 @@ const (
 type AdditionServiceClient interface {
 	// Add numbers and return the sum with enhanced metadata
 	Add(ctx context.Context, in *AddRequest, opts ...grpc.CallOption) (*AddResponse, error)
+	// Perform many additions in one call, returning one response per item
+	BatchAdd(ctx context.Context, in *BatchAddRequest, opts ...grpc.CallOption) (*BatchAddResponse, error)
+	// Add vectors of equal length element-wise
+	AddVectors(ctx context.Context, in *AddVectorsRequest, opts ...grpc.CallOption) (*AddVectorsResponse, error)
+	// Add matrices of equal shape element-wise
+	AddMatrices(ctx context.Context, in *AddMatricesRequest, opts ...grpc.CallOption) (*AddMatricesResponse, error)
+	// Add numbers sent in chunks over a client stream and return the sum once
+	// the stream is closed
+	AddStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[AddStreamRequest, AddResponse], error)
+	// Add numbers and stream partial running totals followed by the final sum
+	AddProgress(ctx context.Context, in *AddProgressRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AddResponse], error)
+	// Interactive session keeping a running accumulator across operations
+	Session(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[SessionRequest, SessionResponse], error)
+	// Subtract every following number from the first one
+	Subtract(ctx context.Context, in *SubtractRequest, opts ...grpc.CallOption) (*SubtractResponse, error)
+	// Multiply numbers and return the product
+	Multiply(ctx context.Context, in *MultiplyRequest, opts ...grpc.CallOption) (*MultiplyResponse, error)
+	// Divide the first number by every following number
+	Divide(ctx context.Context, in *DivideRequest, opts ...grpc.CallOption) (*DivideResponse, error)
+	// Parse and evaluate an infix expression such as "(1.5 + 2) * 3"
+	Evaluate(ctx context.Context, in *EvaluateRequest, opts ...grpc.CallOption) (*EvaluateResponse, error)
+	// Compute descriptive statistics of numbers
+	Describe(ctx context.Context, in *DescribeRequest, opts ...grpc.CallOption) (*DescribeResponse, error)
+	// Page through past calculations, newest first
+	ListCalculations(ctx context.Context, in *ListCalculationsRequest, opts ...grpc.CallOption) (*ListCalculationsResponse, error)
+	// Look up a past calculation by request ID
+	GetCalculation(ctx context.Context, in *GetCalculationRequest, opts ...grpc.CallOption) (*GetCalculationResponse, error)
+	// Queue a calculation to run in the background and return its job
+	SubmitCalculation(ctx context.Context, in *SubmitCalculationRequest, opts ...grpc.CallOption) (*SubmitCalculationResponse, error)
+	// Look up the status and result of a job
+	GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error)
+	// Cancel a queued or running job
+	CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobResponse, error)
+	// Wait until a job has finished or the timeout has passed
+	WaitJob(ctx context.Context, in *WaitJobRequest, opts ...grpc.CallOption) (*WaitJobResponse, error)
 }
 
 type additionServiceClient struct {
@@ -48,12 +100,232 @@ func (c *additionServiceClient) Add(ctx context.Context, in *AddRequest, opts ..
 	return out, nil
 }
 
+func (c *additionServiceClient) BatchAdd(ctx context.Context, in *BatchAddRequest, opts ...grpc.CallOption) (*BatchAddResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchAddResponse)
+	err := c.cc.Invoke(ctx, AdditionService_BatchAdd_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *additionServiceClient) AddVectors(ctx context.Context, in *AddVectorsRequest, opts ...grpc.CallOption) (*AddVectorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddVectorsResponse)
+	err := c.cc.Invoke(ctx, AdditionService_AddVectors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *additionServiceClient) AddMatrices(ctx context.Context, in *AddMatricesRequest, opts ...grpc.CallOption) (*AddMatricesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddMatricesResponse)
+	err := c.cc.Invoke(ctx, AdditionService_AddMatrices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *additionServiceClient) AddStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[AddStreamRequest, AddResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AdditionService_ServiceDesc.Streams[0], AdditionService_AddStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AddStreamRequest, AddResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdditionService_AddStreamClient = grpc.ClientStreamingClient[AddStreamRequest, AddResponse]
+
+func (c *additionServiceClient) AddProgress(ctx context.Context, in *AddProgressRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AddResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AdditionService_ServiceDesc.Streams[1], AdditionService_AddProgress_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AddProgressRequest, AddResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdditionService_AddProgressClient = grpc.ServerStreamingClient[AddResponse]
+
+func (c *additionServiceClient) Session(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[SessionRequest, SessionResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AdditionService_ServiceDesc.Streams[2], AdditionService_Session_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SessionRequest, SessionResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdditionService_SessionClient = grpc.BidiStreamingClient[SessionRequest, SessionResponse]
+
+func (c *additionServiceClient) Subtract(ctx context.Context, in *SubtractRequest, opts ...grpc.CallOption) (*SubtractResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubtractResponse)
+	err := c.cc.Invoke(ctx, AdditionService_Subtract_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *additionServiceClient) Multiply(ctx context.Context, in *MultiplyRequest, opts ...grpc.CallOption) (*MultiplyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultiplyResponse)
+	err := c.cc.Invoke(ctx, AdditionService_Multiply_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *additionServiceClient) Divide(ctx context.Context, in *DivideRequest, opts ...grpc.CallOption) (*DivideResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DivideResponse)
+	err := c.cc.Invoke(ctx, AdditionService_Divide_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *additionServiceClient) Evaluate(ctx context.Context, in *EvaluateRequest, opts ...grpc.CallOption) (*EvaluateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EvaluateResponse)
+	err := c.cc.Invoke(ctx, AdditionService_Evaluate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *additionServiceClient) Describe(ctx context.Context, in *DescribeRequest, opts ...grpc.CallOption) (*DescribeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DescribeResponse)
+	err := c.cc.Invoke(ctx, AdditionService_Describe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *additionServiceClient) ListCalculations(ctx context.Context, in *ListCalculationsRequest, opts ...grpc.CallOption) (*ListCalculationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCalculationsResponse)
+	err := c.cc.Invoke(ctx, AdditionService_ListCalculations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *additionServiceClient) GetCalculation(ctx context.Context, in *GetCalculationRequest, opts ...grpc.CallOption) (*GetCalculationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCalculationResponse)
+	err := c.cc.Invoke(ctx, AdditionService_GetCalculation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *additionServiceClient) SubmitCalculation(ctx context.Context, in *SubmitCalculationRequest, opts ...grpc.CallOption) (*SubmitCalculationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitCalculationResponse)
+	err := c.cc.Invoke(ctx, AdditionService_SubmitCalculation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *additionServiceClient) GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJobResponse)
+	err := c.cc.Invoke(ctx, AdditionService_GetJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *additionServiceClient) CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelJobResponse)
+	err := c.cc.Invoke(ctx, AdditionService_CancelJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *additionServiceClient) WaitJob(ctx context.Context, in *WaitJobRequest, opts ...grpc.CallOption) (*WaitJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WaitJobResponse)
+	err := c.cc.Invoke(ctx, AdditionService_WaitJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdditionServiceServer is the server API for AdditionService service.
 // All implementations must embed UnimplementedAdditionServiceServer
 // for forward compatibility.
 type AdditionServiceServer interface {
 	// Add numbers and return the sum with enhanced metadata
 	Add(context.Context, *AddRequest) (*AddResponse, error)
+	// Perform many additions in one call, returning one response per item
+	BatchAdd(context.Context, *BatchAddRequest) (*BatchAddResponse, error)
+	// Add vectors of equal length element-wise
+	AddVectors(context.Context, *AddVectorsRequest) (*AddVectorsResponse, error)
+	// Add matrices of equal shape element-wise
+	AddMatrices(context.Context, *AddMatricesRequest) (*AddMatricesResponse, error)
+	// Add numbers sent in chunks over a client stream and return the sum once
+	// the stream is closed
+	AddStream(grpc.ClientStreamingServer[AddStreamRequest, AddResponse]) error
+	// Add numbers and stream partial running totals followed by the final sum
+	AddProgress(*AddProgressRequest, grpc.ServerStreamingServer[AddResponse]) error
+	// Interactive session keeping a running accumulator across operations
+	Session(grpc.BidiStreamingServer[SessionRequest, SessionResponse]) error
+	// Subtract every following number from the first one
+	Subtract(context.Context, *SubtractRequest) (*SubtractResponse, error)
+	// Multiply numbers and return the product
+	Multiply(context.Context, *MultiplyRequest) (*MultiplyResponse, error)
+	// Divide the first number by every following number
+	Divide(context.Context, *DivideRequest) (*DivideResponse, error)
+	// Parse and evaluate an infix expression such as "(1.5 + 2) * 3"
+	Evaluate(context.Context, *EvaluateRequest) (*EvaluateResponse, error)
+	// Compute descriptive statistics of numbers
+	Describe(context.Context, *DescribeRequest) (*DescribeResponse, error)
+	// Page through past calculations, newest first
+	ListCalculations(context.Context, *ListCalculationsRequest) (*ListCalculationsResponse, error)
+	// Look up a past calculation by request ID
+	GetCalculation(context.Context, *GetCalculationRequest) (*GetCalculationResponse, error)
+	// Queue a calculation to run in the background and return its job
+	SubmitCalculation(context.Context, *SubmitCalculationRequest) (*SubmitCalculationResponse, error)
+	// Look up the status and result of a job
+	GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error)
+	// Cancel a queued or running job
+	CancelJob(context.Context, *CancelJobRequest) (*CancelJobResponse, error)
+	// Wait until a job has finished or the timeout has passed
+	WaitJob(context.Context, *WaitJobRequest) (*WaitJobResponse, error)
 	mustEmbedUnimplementedAdditionServiceServer()
 }
 
@@ -67,6 +339,57 @@ type UnimplementedAdditionServiceServer struct{}
 func (UnimplementedAdditionServiceServer) Add(context.Context, *AddRequest) (*AddResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Add not implemented")
 }
+func (UnimplementedAdditionServiceServer) BatchAdd(context.Context, *BatchAddRequest) (*BatchAddResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchAdd not implemented")
+}
+func (UnimplementedAdditionServiceServer) AddVectors(context.Context, *AddVectorsRequest) (*AddVectorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddVectors not implemented")
+}
+func (UnimplementedAdditionServiceServer) AddMatrices(context.Context, *AddMatricesRequest) (*AddMatricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMatrices not implemented")
+}
+func (UnimplementedAdditionServiceServer) AddStream(grpc.ClientStreamingServer[AddStreamRequest, AddResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method AddStream not implemented")
+}
+func (UnimplementedAdditionServiceServer) AddProgress(*AddProgressRequest, grpc.ServerStreamingServer[AddResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method AddProgress not implemented")
+}
+func (UnimplementedAdditionServiceServer) Session(grpc.BidiStreamingServer[SessionRequest, SessionResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Session not implemented")
+}
+func (UnimplementedAdditionServiceServer) Subtract(context.Context, *SubtractRequest) (*SubtractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Subtract not implemented")
+}
+func (UnimplementedAdditionServiceServer) Multiply(context.Context, *MultiplyRequest) (*MultiplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Multiply not implemented")
+}
+func (UnimplementedAdditionServiceServer) Divide(context.Context, *DivideRequest) (*DivideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Divide not implemented")
+}
+func (UnimplementedAdditionServiceServer) Evaluate(context.Context, *EvaluateRequest) (*EvaluateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Evaluate not implemented")
+}
+func (UnimplementedAdditionServiceServer) Describe(context.Context, *DescribeRequest) (*DescribeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Describe not implemented")
+}
+func (UnimplementedAdditionServiceServer) ListCalculations(context.Context, *ListCalculationsRequest) (*ListCalculationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCalculations not implemented")
+}
+func (UnimplementedAdditionServiceServer) GetCalculation(context.Context, *GetCalculationRequest) (*GetCalculationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalculation not implemented")
+}
+func (UnimplementedAdditionServiceServer) SubmitCalculation(context.Context, *SubmitCalculationRequest) (*SubmitCalculationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitCalculation not implemented")
+}
+func (UnimplementedAdditionServiceServer) GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJob not implemented")
+}
+func (UnimplementedAdditionServiceServer) CancelJob(context.Context, *CancelJobRequest) (*CancelJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJob not implemented")
+}
+func (UnimplementedAdditionServiceServer) WaitJob(context.Context, *WaitJobRequest) (*WaitJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WaitJob not implemented")
+}
 func (UnimplementedAdditionServiceServer) mustEmbedUnimplementedAdditionServiceServer() {}
 func (UnimplementedAdditionServiceServer) testEmbeddedByValue()                         {}
 
@@ -106,6 +429,283 @@ func _AdditionService_Add_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdditionService_BatchAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdditionServiceServer).BatchAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdditionService_BatchAdd_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdditionServiceServer).BatchAdd(ctx, req.(*BatchAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdditionService_AddVectors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddVectorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdditionServiceServer).AddVectors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdditionService_AddVectors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdditionServiceServer).AddVectors(ctx, req.(*AddVectorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdditionService_AddMatrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMatricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdditionServiceServer).AddMatrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdditionService_AddMatrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdditionServiceServer).AddMatrices(ctx, req.(*AddMatricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdditionService_AddStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AdditionServiceServer).AddStream(&grpc.GenericServerStream[AddStreamRequest, AddResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdditionService_AddStreamServer = grpc.ClientStreamingServer[AddStreamRequest, AddResponse]
+
+func _AdditionService_AddProgress_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AddProgressRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdditionServiceServer).AddProgress(m, &grpc.GenericServerStream[AddProgressRequest, AddResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdditionService_AddProgressServer = grpc.ServerStreamingServer[AddResponse]
+
+func _AdditionService_Session_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AdditionServiceServer).Session(&grpc.GenericServerStream[SessionRequest, SessionResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdditionService_SessionServer = grpc.BidiStreamingServer[SessionRequest, SessionResponse]
+
+func _AdditionService_Subtract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubtractRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdditionServiceServer).Subtract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdditionService_Subtract_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdditionServiceServer).Subtract(ctx, req.(*SubtractRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdditionService_Multiply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdditionServiceServer).Multiply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdditionService_Multiply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdditionServiceServer).Multiply(ctx, req.(*MultiplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdditionService_Divide_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DivideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdditionServiceServer).Divide(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdditionService_Divide_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdditionServiceServer).Divide(ctx, req.(*DivideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdditionService_Evaluate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdditionServiceServer).Evaluate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdditionService_Evaluate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdditionServiceServer).Evaluate(ctx, req.(*EvaluateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdditionService_Describe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdditionServiceServer).Describe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdditionService_Describe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdditionServiceServer).Describe(ctx, req.(*DescribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdditionService_ListCalculations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCalculationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdditionServiceServer).ListCalculations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdditionService_ListCalculations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdditionServiceServer).ListCalculations(ctx, req.(*ListCalculationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdditionService_GetCalculation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCalculationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdditionServiceServer).GetCalculation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdditionService_GetCalculation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdditionServiceServer).GetCalculation(ctx, req.(*GetCalculationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdditionService_SubmitCalculation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitCalculationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdditionServiceServer).SubmitCalculation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdditionService_SubmitCalculation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdditionServiceServer).SubmitCalculation(ctx, req.(*SubmitCalculationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdditionService_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdditionServiceServer).GetJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdditionService_GetJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdditionServiceServer).GetJob(ctx, req.(*GetJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdditionService_CancelJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdditionServiceServer).CancelJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdditionService_CancelJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdditionServiceServer).CancelJob(ctx, req.(*CancelJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdditionService_WaitJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdditionServiceServer).WaitJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdditionService_WaitJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdditionServiceServer).WaitJob(ctx, req.(*WaitJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdditionService_ServiceDesc is the grpc.ServiceDesc for AdditionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -117,7 +717,80 @@ var AdditionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Add",
 			Handler:    _AdditionService_Add_Handler,
 		},
+		{
+			MethodName: "BatchAdd",
+			Handler:    _AdditionService_BatchAdd_Handler,
+		},
+		{
+			MethodName: "AddVectors",
+			Handler:    _AdditionService_AddVectors_Handler,
+		},
+		{
+			MethodName: "AddMatrices",
+			Handler:    _AdditionService_AddMatrices_Handler,
+		},
+		{
+			MethodName: "Subtract",
+			Handler:    _AdditionService_Subtract_Handler,
+		},
+		{
+			MethodName: "Multiply",
+			Handler:    _AdditionService_Multiply_Handler,
+		},
+		{
+			MethodName: "Divide",
+			Handler:    _AdditionService_Divide_Handler,
+		},
+		{
+			MethodName: "Evaluate",
+			Handler:    _AdditionService_Evaluate_Handler,
+		},
+		{
+			MethodName: "Describe",
+			Handler:    _AdditionService_Describe_Handler,
+		},
+		{
+			MethodName: "ListCalculations",
+			Handler:    _AdditionService_ListCalculations_Handler,
+		},
+		{
+			MethodName: "GetCalculation",
+			Handler:    _AdditionService_GetCalculation_Handler,
+		},
+		{
+			MethodName: "SubmitCalculation",
+			Handler:    _AdditionService_SubmitCalculation_Handler,
+		},
+		{
+			MethodName: "GetJob",
+			Handler:    _AdditionService_GetJob_Handler,
+		},
+		{
+			MethodName: "CancelJob",
+			Handler:    _AdditionService_CancelJob_Handler,
+		},
+		{
+			MethodName: "WaitJob",
+			Handler:    _AdditionService_WaitJob_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "AddStream",
+			Handler:       _AdditionService_AddStream_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "AddProgress",
+			Handler:       _AdditionService_AddProgress_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Session",
+			Handler:       _AdditionService_Session_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "calculator/v1/calculator.proto",
 }
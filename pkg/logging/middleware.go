@@ -58,3 +58,56 @@ func UnaryServerInterceptor(logger Logger) grpc.UnaryServerInterceptor {
 		return resp, err
 	}
 }
+
+// StreamServerInterceptor creates a logging interceptor for streaming gRPC calls
+func StreamServerInterceptor(logger Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		// Start timing
+		start := time.Now()
+
+		// Extract request ID from metadata if exists
+		var requestID string
+		if md, ok := metadata.FromIncomingContext(ss.Context()); ok {
+			if ids := md.Get("request-id"); len(ids) > 0 {
+				requestID = ids[0]
+			}
+		}
+
+		// Create logger with request context
+		logCtx := logger.
+			WithRequestID(requestID).
+			With().
+			Str("method", info.FullMethod).
+			Bool("client_stream", info.IsClientStream).
+			Bool("server_stream", info.IsServerStream).
+			Logger()
+
+		// Log stream start
+		logCtx.Info().Msg("Received gRPC stream")
+
+		// Call the actual handler
+		err := handler(srv, ss)
+
+		// Calculate duration
+		duration := time.Since(start)
+
+		// Log stream end
+		if err != nil {
+			logCtx.Error().
+				Err(err).
+				Dur("duration", duration).
+				Msg("gRPC stream failed")
+		} else {
+			logCtx.Info().
+				Dur("duration", duration).
+				Msg("gRPC stream completed")
+		}
+
+		return err
+	}
+}
@@ -3,6 +3,8 @@ package main
 import (
 	"net"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -14,6 +16,11 @@ import (
 
 const (
 	port = ":50051"
+
+	// maxMessageSize is the largest request the server accepts. It holds a
+	// unary Add of several million doubles, well above the operand count
+	// from which additions are performed in parallel.
+	maxMessageSize = 64 << 20
 )
 
 func main() {
@@ -37,13 +44,42 @@ func main() {
 		os.Exit(1)
 	}
 
-	// Create a gRPC server object with logging interceptor
+	// Create a gRPC server object with logging interceptors, passing the
+	// ErrorInfo of failed calls on to the clients
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(logging.UnaryServerInterceptor(logger)),
+		grpc.MaxRecvMsgSize(maxMessageSize),
+		grpc.ChainUnaryInterceptor(
+			logging.UnaryServerInterceptor(logger),
+			service.UnaryServerInterceptor(),
+		),
+		grpc.StreamInterceptor(logging.StreamServerInterceptor(logger)),
 	)
 
 	// Attach the AdditionService implementation
 	calculationService := service.NewAdditionService()
+	calculationService.UseLogger(logger.Logger)
+
+	// Load exchange rates for money conversions if configured
+	if ratesFile := os.Getenv("EXCHANGE_RATES_FILE"); ratesFile != "" {
+		if err := calculationService.LoadExchangeRates(ratesFile); err != nil {
+			logger.Error().
+				Err(err).
+				Str("file", ratesFile).
+				Msg("Failed to load exchange rates")
+			os.Exit(1)
+		}
+	}
+
+	// Persist the calculation history if configured
+	if historyFile := os.Getenv("HISTORY_FILE"); historyFile != "" {
+		if err := calculationService.OpenHistoryFile(historyFile); err != nil {
+			logger.Error().
+				Err(err).
+				Str("file", historyFile).
+				Msg("Failed to open calculation history")
+			os.Exit(1)
+		}
+	}
 	pb.RegisterAdditionServiceServer(grpcServer, calculationService)
 
 	// Register reflection service on gRPC server
@@ -54,6 +90,15 @@ func main() {
 		Str("port", port).
 		Msg("Calculation service listening")
 
+	// Stop serving on SIGINT or SIGTERM, letting running calls finish
+	go func() {
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+		<-signals
+		logger.Info().Msg("Shutting down calculation service")
+		grpcServer.GracefulStop()
+	}()
+
 	// Start gRPC server
 	if err := grpcServer.Serve(lis); err != nil {
 		logger.Error().
@@ -61,4 +106,12 @@ func main() {
 			Msg("Failed to serve gRPC server")
 		os.Exit(1)
 	}
+
+	// Save the pending history records and close the history file
+	if err := calculationService.Close(); err != nil {
+		logger.Error().
+			Err(err).
+			Msg("Failed to close calculation service")
+		os.Exit(1)
+	}
 }
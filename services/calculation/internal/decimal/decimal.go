@@ -0,0 +1,131 @@
+// Package decimal implements exact decimal arithmetic on top of math/big.
+package decimal
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// RoundingMode selects how a value is rounded to a fixed scale
+type RoundingMode int
+
+const (
+	// HalfEven rounds to the nearest neighbour, ties to the even one
+	HalfEven RoundingMode = iota
+	// HalfUp rounds to the nearest neighbour, ties away from zero
+	HalfUp
+	// HalfDown rounds to the nearest neighbour, ties towards zero
+	HalfDown
+	// Down truncates towards zero
+	Down
+	// Up rounds away from zero
+	Up
+	// Ceiling rounds towards positive infinity
+	Ceiling
+	// Floor rounds towards negative infinity
+	Floor
+)
+
+// MaxScale is the largest number of fractional digits accepted
+const MaxScale = 1000
+
+var decimalPattern = regexp.MustCompile(`^[+-]?(\d+(\.\d*)?|\.\d+)$`)
+
+// Parse converts a plain decimal string such as "-12.034" into an exact
+// rational value. Exponents, fractions and special values are rejected.
+func Parse(s string) (*big.Rat, error) {
+	s = strings.TrimSpace(s)
+	if !decimalPattern.MatchString(s) {
+		return nil, fmt.Errorf("invalid decimal %q", s)
+	}
+	if fraction := strings.IndexByte(s, '.'); fraction >= 0 && len(s)-fraction-1 > MaxScale {
+		return nil, fmt.Errorf("decimal %q has more than %d fractional digits", s, MaxScale)
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("invalid decimal %q", s)
+	}
+	return r, nil
+}
+
+// Scale returns the number of fractional digits needed to represent r
+// exactly, or false if r has no finite decimal representation
+func Scale(r *big.Rat) (int, bool) {
+	denom := new(big.Int).Set(r.Denom())
+	two, five := big.NewInt(2), big.NewInt(5)
+	rem := new(big.Int)
+
+	var twos, fives int
+	for {
+		q, m := new(big.Int).QuoRem(denom, two, rem)
+		if m.Sign() != 0 {
+			break
+		}
+		denom = q
+		twos++
+	}
+	for {
+		q, m := new(big.Int).QuoRem(denom, five, rem)
+		if m.Sign() != 0 {
+			break
+		}
+		denom = q
+		fives++
+	}
+
+	if denom.Cmp(big.NewInt(1)) != 0 {
+		return 0, false
+	}
+	return max(twos, fives), true
+}
+
+// Round rounds r to scale fractional digits using the given mode
+func Round(r *big.Rat, scale int, mode RoundingMode) *big.Rat {
+	factor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)
+	scaled := new(big.Rat).Mul(r, new(big.Rat).SetInt(factor))
+
+	quo, rem := new(big.Int).QuoRem(scaled.Num(), scaled.Denom(), new(big.Int))
+	if rem.Sign() != 0 {
+		// Compare twice the remainder with the denominator to find ties
+		twiceRem := new(big.Int).Abs(rem)
+		twiceRem.Lsh(twiceRem, 1)
+		cmpHalf := twiceRem.Cmp(scaled.Denom())
+		negative := scaled.Sign() < 0
+
+		var awayFromZero bool
+		switch mode {
+		case HalfEven:
+			awayFromZero = cmpHalf > 0 || (cmpHalf == 0 && quo.Bit(0) == 1)
+		case HalfUp:
+			awayFromZero = cmpHalf >= 0
+		case HalfDown:
+			awayFromZero = cmpHalf > 0
+		case Down:
+			awayFromZero = false
+		case Up:
+			awayFromZero = true
+		case Ceiling:
+			awayFromZero = !negative
+		case Floor:
+			awayFromZero = negative
+		}
+
+		if awayFromZero {
+			if negative {
+				quo.Sub(quo, big.NewInt(1))
+			} else {
+				quo.Add(quo, big.NewInt(1))
+			}
+		}
+	}
+
+	return new(big.Rat).SetFrac(quo, factor)
+}
+
+// Format renders r with exactly scale fractional digits. r must already be
+// representable at that scale, for example the result of Round.
+func Format(r *big.Rat, scale int) string {
+	return r.FloatString(scale)
+}
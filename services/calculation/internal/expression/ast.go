@@ -0,0 +1,166 @@
+package expression
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrDivisionByZero is returned when an expression divides by zero
+var ErrDivisionByZero = errors.New("division by zero")
+
+// ErrOverflow is returned when an expression evaluates to infinity
+var ErrOverflow = errors.New("calculation overflow")
+
+// Node is a node of the expression syntax tree
+type Node interface {
+	// Eval computes the value of the node
+	Eval() (float64, error)
+
+	// Pos returns the zero-based offset of the node in the source expression
+	Pos() int
+}
+
+// Number is a numeric literal
+type Number struct {
+	Value    float64
+	Position int
+}
+
+// Eval returns the literal value
+func (n *Number) Eval() (float64, error) {
+	return n.Value, nil
+}
+
+// Pos returns the offset of the literal
+func (n *Number) Pos() int {
+	return n.Position
+}
+
+// Unary is a prefix operation such as negation
+type Unary struct {
+	Op       byte
+	Operand  Node
+	Position int
+}
+
+// Eval applies the prefix operator to the operand
+func (u *Unary) Eval() (float64, error) {
+	value, err := u.Operand.Eval()
+	if err != nil {
+		return 0, err
+	}
+	if u.Op == '-' {
+		return -value, nil
+	}
+	return value, nil
+}
+
+// Pos returns the offset of the operator
+func (u *Unary) Pos() int {
+	return u.Position
+}
+
+// Binary is an infix operation on two operands
+type Binary struct {
+	Op       byte
+	Left     Node
+	Right    Node
+	Position int
+}
+
+// Eval applies the infix operator to both operands. The parser builds
+// chains such as 1+1+…+1 as left-deep trees, so the left operands are
+// evaluated iteratively rather than recursing once per operator.
+func (b *Binary) Eval() (float64, error) {
+	chain := leftChain(b)
+	value, err := chain[len(chain)-1].Left.Eval()
+	if err != nil {
+		return 0, err
+	}
+	for i := len(chain) - 1; i >= 0; i-- {
+		if value, err = chain[i].apply(value); err != nil {
+			return 0, err
+		}
+	}
+	return value, nil
+}
+
+// apply evaluates the right operand and applies the infix operator to the
+// value of the left operand and it
+func (b *Binary) apply(left float64) (float64, error) {
+	right, err := b.Right.Eval()
+	if err != nil {
+		return 0, err
+	}
+
+	var result float64
+	switch b.Op {
+	case '+':
+		result = left + right
+	case '-':
+		result = left - right
+	case '*':
+		result = left * right
+	case '/':
+		if right == 0 {
+			return 0, &EvalError{Err: ErrDivisionByZero, Position: b.Position}
+		}
+		result = left / right
+	}
+
+	if math.IsInf(result, 0) {
+		return 0, &EvalError{Err: ErrOverflow, Position: b.Position}
+	}
+	return result, nil
+}
+
+// leftChain returns b followed by every binary operation reached through
+// the left operands, innermost last
+func leftChain(b *Binary) []*Binary {
+	chain := []*Binary{b}
+	for {
+		left, ok := chain[len(chain)-1].Left.(*Binary)
+		if !ok {
+			return chain
+		}
+		chain = append(chain, left)
+	}
+}
+
+// Pos returns the offset of the operator
+func (b *Binary) Pos() int {
+	return b.Position
+}
+
+// EvalError is an evaluation failure at a position in the expression
+type EvalError struct {
+	Err      error
+	Position int
+}
+
+func (e *EvalError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *EvalError) Unwrap() error {
+	return e.Err
+}
+
+// CountNumbers returns the number of numeric literals in the tree. Like
+// Eval, it walks chains of left operands iteratively.
+func CountNumbers(node Node) int {
+	switch n := node.(type) {
+	case *Number:
+		return 1
+	case *Unary:
+		return CountNumbers(n.Operand)
+	case *Binary:
+		chain := leftChain(n)
+		count := CountNumbers(chain[len(chain)-1].Left)
+		for _, b := range chain {
+			count += CountNumbers(b.Right)
+		}
+		return count
+	}
+	return 0
+}
@@ -0,0 +1,247 @@
+package expression
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseError describes a syntax error at a position in the expression
+type ParseError struct {
+	Position int
+	Message  string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("position %d: %s", e.Position, e.Message)
+}
+
+// ParseErrors collects every syntax error found in an expression
+type ParseErrors []*ParseError
+
+func (e ParseErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenNumber
+	tokenOperator
+	tokenLeftParen
+	tokenRightParen
+)
+
+type token struct {
+	kind     tokenKind
+	text     string
+	value    float64
+	position int
+}
+
+// tokenize splits the input into tokens, collecting an error for every
+// character that cannot start a token
+func tokenize(input string) ([]token, ParseErrors) {
+	var tokens []token
+	var errs ParseErrors
+
+	for i := 0; i < len(input); {
+		c := input[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '+' || c == '-' || c == '*' || c == '/':
+			tokens = append(tokens, token{kind: tokenOperator, text: string(c), position: i})
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenLeftParen, text: "(", position: i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenRightParen, text: ")", position: i})
+			i++
+		case isDigit(c) || c == '.':
+			start := i
+			i = scanNumber(input, i)
+			text := input[start:i]
+			value, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				errs = append(errs, &ParseError{Position: start, Message: fmt.Sprintf("invalid number %q", text)})
+				continue
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: text, value: value, position: start})
+		default:
+			errs = append(errs, &ParseError{Position: i, Message: fmt.Sprintf("unexpected character %q", c)})
+			i++
+		}
+	}
+
+	tokens = append(tokens, token{kind: tokenEOF, position: len(input)})
+	return tokens, errs
+}
+
+// scanNumber returns the end offset of the numeric literal starting at i
+func scanNumber(input string, i int) int {
+	for i < len(input) && (isDigit(input[i]) || input[i] == '.') {
+		i++
+	}
+	if i < len(input) && (input[i] == 'e' || input[i] == 'E') {
+		j := i + 1
+		if j < len(input) && (input[j] == '+' || input[j] == '-') {
+			j++
+		}
+		if j < len(input) && isDigit(input[j]) {
+			for j < len(input) && isDigit(input[j]) {
+				j++
+			}
+			i = j
+		}
+	}
+	return i
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// MaxDepth caps the nesting of parentheses and unary operators, so that
+// untrusted input cannot exhaust the stack of the recursive descent. Chains
+// of binary operators are parsed and evaluated iteratively and do not count.
+const MaxDepth = 1000
+
+type parser struct {
+	tokens []token
+	pos    int
+	depth  int
+}
+
+// Parse builds a syntax tree from an infix expression. Supported are
+// numbers, parentheses, unary plus and minus, and the binary operators
+// + - * / with the usual precedence.
+func Parse(input string) (Node, error) {
+	tokens, errs := tokenize(input)
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	p := &parser{tokens: tokens}
+	if p.peek().kind == tokenEOF {
+		return nil, ParseErrors{{Position: 0, Message: "empty expression"}}
+	}
+
+	node, err := p.parseExpression()
+	if err != nil {
+		return nil, ParseErrors{err}
+	}
+	if next := p.peek(); next.kind != tokenEOF {
+		return nil, ParseErrors{{Position: next.position, Message: fmt.Sprintf("unexpected %q", next.text)}}
+	}
+	return node, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+// enter descends one nesting level at t, failing beyond MaxDepth. Every
+// successful enter is paired with a leave.
+func (p *parser) enter(t token) *ParseError {
+	if p.depth == MaxDepth {
+		return &ParseError{Position: t.position, Message: fmt.Sprintf("expression is nested deeper than %d levels", MaxDepth)}
+	}
+	p.depth++
+	return nil
+}
+
+func (p *parser) leave() {
+	p.depth--
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+// parseExpression handles addition and subtraction
+func (p *parser) parseExpression() (Node, *ParseError) {
+	left, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+	for t := p.peek(); t.kind == tokenOperator && (t.text == "+" || t.text == "-"); t = p.peek() {
+		p.next()
+		right, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		left = &Binary{Op: t.text[0], Left: left, Right: right, Position: t.position}
+	}
+	return left, nil
+}
+
+// parseTerm handles multiplication and division
+func (p *parser) parseTerm() (Node, *ParseError) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for t := p.peek(); t.kind == tokenOperator && (t.text == "*" || t.text == "/"); t = p.peek() {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &Binary{Op: t.text[0], Left: left, Right: right, Position: t.position}
+	}
+	return left, nil
+}
+
+// parseUnary handles prefix plus and minus
+func (p *parser) parseUnary() (Node, *ParseError) {
+	if t := p.peek(); t.kind == tokenOperator && (t.text == "+" || t.text == "-") {
+		p.next()
+		if err := p.enter(t); err != nil {
+			return nil, err
+		}
+		defer p.leave()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &Unary{Op: t.text[0], Operand: operand, Position: t.position}, nil
+	}
+	return p.parsePrimary()
+}
+
+// parsePrimary handles numbers and parenthesised expressions
+func (p *parser) parsePrimary() (Node, *ParseError) {
+	t := p.next()
+	switch t.kind {
+	case tokenNumber:
+		return &Number{Value: t.value, Position: t.position}, nil
+	case tokenLeftParen:
+		if err := p.enter(t); err != nil {
+			return nil, err
+		}
+		defer p.leave()
+		node, err := p.parseExpression()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokenRightParen {
+			return nil, &ParseError{Position: closing.position, Message: fmt.Sprintf("missing closing parenthesis for '(' at position %d", t.position)}
+		}
+		return node, nil
+	case tokenEOF:
+		return nil, &ParseError{Position: t.position, Message: "unexpected end of expression"}
+	default:
+		return nil, &ParseError{Position: t.position, Message: fmt.Sprintf("unexpected %q", t.text)}
+	}
+}
@@ -0,0 +1,196 @@
+package history
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// FileRepository appends calculations to a file with one JSON record per
+// line. Only the location of every record is kept in memory; the records are
+// read back from the file when requested.
+type FileRepository struct {
+	mu   sync.RWMutex
+	file *os.File
+	size int64
+	// entries locates every record in the file, in the order recorded
+	entries []fileEntry
+	// latest maps request IDs to the index of their latest entry
+	latest map[string]int
+}
+
+// fileEntry locates a record in the history file. The payloads are left out
+// of the record, which only serves for filtering.
+type fileEntry struct {
+	record Record
+	offset int64
+	length int
+}
+
+// OpenFileRepository opens the history file at path, creating it if needed,
+// and indexes the calculations recorded in it. A last line that cannot be
+// decoded is left over from an interrupted write and is cut off; an
+// undecodable line before it fails.
+func OpenFileRepository(path string) (*FileRepository, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("open history: %w", err)
+	}
+
+	r := &FileRepository{file: file, latest: make(map[string]int)}
+	if err := r.load(); err != nil {
+		file.Close()
+		return nil, err
+	}
+	return r, nil
+}
+
+// load indexes the records in the file, repairing its end if needed
+func (r *FileRepository) load() error {
+	reader := bufio.NewReader(r.file)
+	for line := 1; ; line++ {
+		data, err := reader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return fmt.Errorf("read history: %w", err)
+		}
+		if len(data) == 0 {
+			return nil
+		}
+		last := err == io.EOF
+		if !last {
+			if _, peekErr := reader.Peek(1); peekErr == io.EOF {
+				last = true
+			}
+		}
+
+		terminated := data[len(data)-1] == '\n'
+		var record Record
+		if decodeErr := json.Unmarshal(bytes.TrimSuffix(data, []byte{'\n'}), &record); decodeErr != nil {
+			if !last {
+				return fmt.Errorf("decode history line %d: %w", line, decodeErr)
+			}
+			if err := r.file.Truncate(r.size); err != nil {
+				return fmt.Errorf("truncate history: %w", err)
+			}
+			return r.file.Sync()
+		}
+
+		length := len(data)
+		if terminated {
+			length--
+		} else if _, err := r.file.Write([]byte{'\n'}); err != nil {
+			return fmt.Errorf("write history: %w", err)
+		}
+		r.index(record, length)
+		if last {
+			return nil
+		}
+	}
+}
+
+// index records the location of a line of length bytes appended to the file
+func (r *FileRepository) index(record Record, length int) {
+	record.Request = nil
+	record.Response = nil
+	r.latest[record.RequestID] = len(r.entries)
+	r.entries = append(r.entries, fileEntry{record: record, offset: r.size, length: length})
+	r.size += int64(length) + 1
+}
+
+// Save appends calculations to the file and waits until they are stored.
+// The file is synced once for all of them.
+func (r *FileRepository) Save(ctx context.Context, records ...Record) error {
+	var data []byte
+	lengths := make([]int, len(records))
+	for i, record := range records {
+		line, err := json.Marshal(record)
+		if err != nil {
+			return fmt.Errorf("encode history record: %w", err)
+		}
+		data = append(append(data, line...), '\n')
+		lengths[i] = len(line)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.file.Write(data); err != nil {
+		// Cut off partly written records, so the next one starts on a line
+		// of its own
+		r.file.Truncate(r.size)
+		return fmt.Errorf("write history: %w", err)
+	}
+	if err := r.file.Sync(); err != nil {
+		return fmt.Errorf("sync history: %w", err)
+	}
+	for i, record := range records {
+		r.index(record, lengths[i])
+	}
+	return nil
+}
+
+// Get returns the latest calculation recorded for a request ID
+func (r *FileRepository) Get(ctx context.Context, requestID string) (Record, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	i, ok := r.latest[requestID]
+	if !ok {
+		return Record{}, ErrNotFound
+	}
+	return r.read(r.entries[i])
+}
+
+// List returns up to size calculations matching filter, newest first
+func (r *FileRepository) List(ctx context.Context, filter Filter, token string, size int) ([]Record, string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	position, err := pagePosition(token, len(r.entries)-1)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var page []Record
+	for ; position >= 0; position-- {
+		entry := r.entries[position]
+		if !filter.Matches(entry.record) {
+			continue
+		}
+		if len(page) == size {
+			return page, pageToken(position), nil
+		}
+		record, err := r.read(entry)
+		if err != nil {
+			return nil, "", err
+		}
+		page = append(page, record)
+	}
+	return page, "", nil
+}
+
+// read decodes the record at the location of entry
+func (r *FileRepository) read(entry fileEntry) (Record, error) {
+	data := make([]byte, entry.length)
+	if _, err := r.file.ReadAt(data, entry.offset); err != nil && err != io.EOF {
+		return Record{}, fmt.Errorf("read history: %w", err)
+	}
+
+	var record Record
+	if err := json.Unmarshal(data, &record); err != nil {
+		return Record{}, fmt.Errorf("decode history record: %w", err)
+	}
+	return record, nil
+}
+
+// Close closes the history file
+func (r *FileRepository) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.file.Close()
+}
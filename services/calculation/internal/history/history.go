@@ -0,0 +1,105 @@
+// Package history records the calculations performed by the calculation
+// service, so that they can be looked up by request ID later.
+package history
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrNotFound is returned when no calculation is recorded for a request ID
+var ErrNotFound = errors.New("calculation not found")
+
+// ErrInvalidPageToken is returned for a page token not issued by List
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// Record is a recorded calculation
+type Record struct {
+	// Request ID of the calculation
+	RequestID string `json:"request_id"`
+	// Operation performed, such as "add"
+	Operation string `json:"operation"`
+	// Request as received, in its JSON form, keeping the first values of
+	// long lists
+	Request json.RawMessage `json:"request"`
+	// Response as returned, in its JSON form, keeping the first values of
+	// long lists
+	Response json.RawMessage `json:"response"`
+	// Number of operands the calculation processed
+	NumbersProcessed int32 `json:"numbers_processed,omitempty"`
+	// Whether lists or strings were cut short in Request or Response
+	Truncated bool `json:"truncated,omitempty"`
+	// Error code of a failed calculation
+	ErrorCode string `json:"error_code,omitempty"`
+	// Calculation method of a successful calculation
+	CalculationMethod string `json:"calculation_method,omitempty"`
+	// Time the calculation started
+	Time time.Time `json:"time"`
+	// Time the calculation took
+	Duration time.Duration `json:"duration"`
+}
+
+// Repository stores recorded calculations
+type Repository interface {
+	// Save records calculations in the order given
+	Save(ctx context.Context, records ...Record) error
+	// Get returns the latest calculation recorded for a request ID
+	Get(ctx context.Context, requestID string) (Record, error)
+	// List returns up to size calculations matching filter, newest first,
+	// starting at the page identified by token, and the token of the next
+	// page, which is empty on the last page
+	List(ctx context.Context, filter Filter, token string, size int) ([]Record, string, error)
+	// Close releases the resources of the repository
+	Close() error
+}
+
+// Filter selects calculations. Zero fields match every calculation.
+type Filter struct {
+	// Only calculations started at or after Start
+	Start time.Time
+	// Only calculations started before End
+	End time.Time
+	// Only calculations that failed with this error code
+	ErrorCode string
+	// Only calculations using this calculation method
+	CalculationMethod string
+	// Only calculations whose request ID starts with this prefix
+	RequestIDPrefix string
+}
+
+// Matches reports whether the filter selects a calculation
+func (f Filter) Matches(record Record) bool {
+	return (f.Start.IsZero() || !record.Time.Before(f.Start)) &&
+		(f.End.IsZero() || record.Time.Before(f.End)) &&
+		(f.ErrorCode == "" || record.ErrorCode == f.ErrorCode) &&
+		(f.CalculationMethod == "" || record.CalculationMethod == f.CalculationMethod) &&
+		strings.HasPrefix(record.RequestID, f.RequestIDPrefix)
+}
+
+// pageToken encodes the position of the first calculation of a page, counting
+// every calculation ever recorded
+func pageToken(position int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(position)))
+}
+
+// pagePosition decodes a page token. The empty token starts at last, the
+// position of the newest calculation.
+func pagePosition(token string, last int) (int, error) {
+	if token == "" {
+		return last, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, ErrInvalidPageToken
+	}
+	position, err := strconv.Atoi(string(data))
+	if err != nil || position < 0 || position > last {
+		return 0, ErrInvalidPageToken
+	}
+	return position, nil
+}
@@ -0,0 +1,107 @@
+package history
+
+import (
+	"context"
+	"sync"
+)
+
+// recordOverhead estimates the memory taken by a record besides its strings
+// and payloads
+const recordOverhead = 128
+
+// MemoryRepository keeps the most recent calculations in memory
+type MemoryRepository struct {
+	mu sync.RWMutex
+	// capacity is the number of bytes the records may take
+	capacity int
+	// size is the number of bytes the records take
+	size    int
+	records []Record
+	// forgotten counts the records evicted from the start of records
+	forgotten int
+	// latest maps request IDs to the position of their latest record,
+	// counting forgotten records
+	latest map[string]int
+}
+
+// NewMemoryRepository creates an empty in-memory repository keeping the most
+// recent calculations that fit in capacity bytes, or all of them if capacity
+// is zero
+func NewMemoryRepository(capacity int) *MemoryRepository {
+	return &MemoryRepository{
+		capacity: capacity,
+		latest:   make(map[string]int),
+	}
+}
+
+// Save records calculations, forgetting the oldest ones when full
+func (r *MemoryRepository) Save(ctx context.Context, records ...Record) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, record := range records {
+		r.latest[record.RequestID] = r.forgotten + len(r.records)
+		r.records = append(r.records, record)
+		r.size += recordSize(record)
+	}
+
+	for r.capacity > 0 && r.size > r.capacity && len(r.records) > 1 {
+		oldest := r.records[0]
+		if r.latest[oldest.RequestID] == r.forgotten {
+			delete(r.latest, oldest.RequestID)
+		}
+		r.size -= recordSize(oldest)
+		r.records[0] = Record{}
+		r.records = r.records[1:]
+		r.forgotten++
+	}
+	return nil
+}
+
+// Get returns the latest calculation recorded for a request ID
+func (r *MemoryRepository) Get(ctx context.Context, requestID string) (Record, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	i, ok := r.latest[requestID]
+	if !ok {
+		return Record{}, ErrNotFound
+	}
+	return r.records[i-r.forgotten], nil
+}
+
+// List returns up to size calculations matching filter, newest first
+func (r *MemoryRepository) List(ctx context.Context, filter Filter, token string, size int) ([]Record, string, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	position, err := pagePosition(token, r.forgotten+len(r.records)-1)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var page []Record
+	for ; position >= r.forgotten; position-- {
+		record := r.records[position-r.forgotten]
+		if !filter.Matches(record) {
+			continue
+		}
+		if len(page) == size {
+			return page, pageToken(position), nil
+		}
+		page = append(page, record)
+	}
+	return page, "", nil
+}
+
+// Close does nothing, as there is nothing to release
+func (r *MemoryRepository) Close() error {
+	return nil
+}
+
+// recordSize estimates the number of bytes a record takes in memory
+func recordSize(record Record) int {
+	return recordOverhead + len(record.RequestID) + len(record.Operation) +
+		len(record.Request) + len(record.Response) +
+		len(record.ErrorCode) + len(record.CalculationMethod)
+}
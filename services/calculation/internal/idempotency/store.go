@@ -0,0 +1,156 @@
+// Package idempotency remembers responses by request ID so that retried
+// requests are answered with the original response instead of being
+// processed again.
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrConflict is returned when a request ID is reused for a different payload
+var ErrConflict = errors.New("request ID reused with a different payload")
+
+// Fingerprint identifies the payload of a request
+type Fingerprint [sha256.Size]byte
+
+// NewFingerprint hashes a serialized request payload
+func NewFingerprint(payload []byte) Fingerprint {
+	return sha256.Sum256(payload)
+}
+
+// entry is a response together with the payload it answers. The response is
+// set once done is closed.
+type entry[T any] struct {
+	fingerprint Fingerprint
+	response    T
+	expires     time.Time
+	done        chan struct{}
+	// forgotten reports that the response is not remembered, because the
+	// computation panicked or asked not to remember it
+	forgotten bool
+}
+
+// queued is a key in the order it was stored. The entry tells apart a key
+// stored again after it expired.
+type queued[T any] struct {
+	key   string
+	entry *entry[T]
+}
+
+// Store remembers the responses of the most recent requests for a limited
+// time. Once it holds more than capacity responses, the oldest one is
+// forgotten.
+type Store[T any] struct {
+	mu       sync.Mutex
+	capacity int
+	ttl      time.Duration
+	entries  map[string]*entry[T]
+	// order holds the stored keys, oldest first, starting at head
+	order []queued[T]
+	head  int
+}
+
+// NewStore creates a store keeping at most capacity responses for ttl each
+func NewStore[T any](capacity int, ttl time.Duration) *Store[T] {
+	return &Store[T]{
+		capacity: capacity,
+		ttl:      ttl,
+		entries:  make(map[string]*entry[T]),
+	}
+}
+
+// Do returns the response remembered for key, or computes and remembers it
+// unless compute reports that it should not be remembered. While a response
+// is being computed, calls for the same key wait for it instead of computing
+// it again. Do reports whether the response was remembered, and fails with
+// ErrConflict if it answers a payload with another fingerprint, or with the
+// context error if ctx ends while waiting.
+func (s *Store[T]) Do(ctx context.Context, key string, fingerprint Fingerprint, compute func() (T, bool)) (T, bool, error) {
+	var zero T
+	for {
+		s.mu.Lock()
+		e, ok := s.entries[key]
+		if ok && time.Now().After(e.expires) {
+			ok = false
+		}
+		if !ok {
+			e = s.store(key, fingerprint)
+			s.mu.Unlock()
+			return s.compute(key, e, compute), false, nil
+		}
+		s.mu.Unlock()
+
+		if e.fingerprint != fingerprint {
+			return zero, false, ErrConflict
+		}
+		select {
+		case <-e.done:
+		case <-ctx.Done():
+			return zero, false, ctx.Err()
+		}
+		if !e.forgotten {
+			return e.response, true, nil
+		}
+	}
+}
+
+// store adds a pending entry for key, forgetting expired entries and the
+// oldest ones beyond the capacity
+func (s *Store[T]) store(key string, fingerprint Fingerprint) *entry[T] {
+	now := time.Now()
+	e := &entry[T]{
+		fingerprint: fingerprint,
+		expires:     now.Add(s.ttl),
+		done:        make(chan struct{}),
+	}
+	s.entries[key] = e
+	s.order = append(s.order, queued[T]{key: key, entry: e})
+
+	for s.head < len(s.order) {
+		oldest := s.order[s.head]
+		current, ok := s.entries[oldest.key]
+		stale := !ok || current != oldest.entry
+		if !stale && len(s.entries) <= s.capacity && !now.After(oldest.entry.expires) {
+			break
+		}
+		if !stale {
+			delete(s.entries, oldest.key)
+		}
+		s.order[s.head] = queued[T]{}
+		s.head++
+	}
+
+	// Move the remaining keys to the start once most of order is forgotten
+	if s.head > len(s.order)/2 {
+		n := copy(s.order, s.order[s.head:])
+		clear(s.order[n:])
+		s.order = s.order[:n]
+		s.head = 0
+	}
+	return e
+}
+
+// compute computes the response of a pending entry and wakes up the calls
+// waiting for it. If the response is not to be remembered or compute panics,
+// the entry is forgotten so that a waiting call computes the response itself.
+func (s *Store[T]) compute(key string, e *entry[T], compute func() (T, bool)) T {
+	remember := false
+	defer func() {
+		if !remember {
+			s.mu.Lock()
+			if s.entries[key] == e {
+				delete(s.entries, key)
+			}
+			s.mu.Unlock()
+			e.forgotten = true
+		}
+		close(e.done)
+	}()
+
+	e.response, remember = compute()
+	return e.response
+}
@@ -0,0 +1,100 @@
+// Package interval implements interval arithmetic with outward (directed)
+// rounding, so that every result is guaranteed to contain the exact value.
+package interval
+
+import (
+	"errors"
+	"math"
+)
+
+// Interval is the closed interval [Lo, Hi]
+type Interval struct {
+	Lo, Hi float64
+}
+
+var (
+	// ErrInvalidBounds is returned for NaN, infinite or reversed bounds
+	ErrInvalidBounds = errors.New("interval bounds must be finite with lo <= hi")
+	// ErrInvalidUncertainty is returned for NaN, infinite or negative uncertainties
+	ErrInvalidUncertainty = errors.New("uncertainty must be finite and non-negative")
+)
+
+// New creates the interval [lo, hi]
+func New(lo, hi float64) (Interval, error) {
+	if !isFinite(lo) || !isFinite(hi) || lo > hi {
+		return Interval{}, ErrInvalidBounds
+	}
+	return Interval{Lo: lo, Hi: hi}, nil
+}
+
+// FromUncertainty creates the smallest interval of doubles containing
+// [value - uncertainty, value + uncertainty]
+func FromUncertainty(value, uncertainty float64) (Interval, error) {
+	if !isFinite(value) {
+		return Interval{}, ErrInvalidBounds
+	}
+	if !isFinite(uncertainty) || uncertainty < 0 {
+		return Interval{}, ErrInvalidUncertainty
+	}
+	return Interval{Lo: AddDown(value, -uncertainty), Hi: AddUp(value, uncertainty)}, nil
+}
+
+// Add returns an interval containing every sum of a value from a and a value
+// from b
+func Add(a, b Interval) Interval {
+	return Interval{Lo: AddDown(a.Lo, b.Lo), Hi: AddUp(a.Hi, b.Hi)}
+}
+
+// Sum adds the intervals. The result is the zero interval for no operands.
+func Sum(intervals []Interval) Interval {
+	var sum Interval
+	for _, iv := range intervals {
+		sum = Add(sum, iv)
+	}
+	return sum
+}
+
+// Midpoint returns the double closest to the centre of the interval
+func (iv Interval) Midpoint() float64 {
+	return iv.Lo/2 + iv.Hi/2
+}
+
+// AddDown returns the largest double not greater than the exact sum a + b
+func AddDown(a, b float64) float64 {
+	sum, err := twoSum(a, b)
+	if math.IsInf(sum, 1) && isFinite(a) && isFinite(b) {
+		return math.MaxFloat64
+	}
+	if err < 0 {
+		return math.Nextafter(sum, math.Inf(-1))
+	}
+	return sum
+}
+
+// AddUp returns the smallest double not less than the exact sum a + b
+func AddUp(a, b float64) float64 {
+	sum, err := twoSum(a, b)
+	if math.IsInf(sum, -1) && isFinite(a) && isFinite(b) {
+		return -math.MaxFloat64
+	}
+	if err > 0 {
+		return math.Nextafter(sum, math.Inf(1))
+	}
+	return sum
+}
+
+// twoSum returns the rounded sum of a and b together with its rounding
+// error, so that sum + err == a + b exactly (Knuth's TwoSum)
+func twoSum(a, b float64) (sum, err float64) {
+	sum = a + b
+	if math.IsInf(sum, 0) {
+		return sum, 0
+	}
+	bv := sum - a
+	av := sum - bv
+	return sum, (a - av) + (b - bv)
+}
+
+func isFinite(f float64) bool {
+	return !math.IsInf(f, 0) && !math.IsNaN(f)
+}
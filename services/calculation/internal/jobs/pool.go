@@ -0,0 +1,269 @@
+// Package jobs runs calculations in the background on a fixed pool of
+// workers and keeps track of their status.
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrNotFound is returned for an unknown or forgotten job
+	ErrNotFound = errors.New("job not found")
+
+	// ErrQueueFull is returned when no more jobs can be queued
+	ErrQueueFull = errors.New("job queue is full")
+
+	// ErrClosed is returned when submitting to a closed pool
+	ErrClosed = errors.New("job pool is closed")
+
+	// ErrPanicked is the error of a job whose task panicked
+	ErrPanicked = errors.New("job panicked")
+)
+
+// Status is the stage of a job
+type Status int
+
+const (
+	// Queued jobs wait for a free worker
+	Queued Status = iota
+	// Running jobs are being performed by a worker
+	Running
+	// Done jobs finished successfully
+	Done
+	// Failed jobs finished with an error
+	Failed
+	// Cancelled jobs were cancelled before they finished
+	Cancelled
+)
+
+// Finished reports whether the status is final
+func (s Status) Finished() bool {
+	return s == Done || s == Failed || s == Cancelled
+}
+
+// Task is the work of a job. Its result is kept when it fails, as it may
+// describe the failure.
+type Task[T any] func(ctx context.Context) (T, error)
+
+// Job is a snapshot of a submitted task
+type Job[T any] struct {
+	ID        string
+	Status    Status
+	Result    T
+	Submitted time.Time
+	Started   time.Time
+	Finished  time.Time
+}
+
+// job is the state of a submitted task
+type job[T any] struct {
+	Job[T]
+	task   Task[T]
+	cancel context.CancelFunc
+	// cancelled is set once the job was asked to cancel
+	cancelled bool
+	// done is closed once the job has finished
+	done chan struct{}
+}
+
+// Pool performs jobs on a fixed number of workers, in submission order
+type Pool[T any] struct {
+	mu        sync.Mutex
+	jobs      map[string]*job[T]
+	queue     chan *job[T]
+	retention time.Duration
+	closed    bool
+
+	ctx  context.Context
+	stop context.CancelFunc
+	wg   sync.WaitGroup
+}
+
+// NewPool starts workers that perform up to queueSize queued jobs. Finished
+// jobs are forgotten after retention.
+func NewPool[T any](workers, queueSize int, retention time.Duration) *Pool[T] {
+	ctx, stop := context.WithCancel(context.Background())
+	p := &Pool[T]{
+		jobs:      make(map[string]*job[T]),
+		queue:     make(chan *job[T], queueSize),
+		retention: retention,
+		ctx:       ctx,
+		stop:      stop,
+	}
+	for range workers {
+		p.wg.Add(1)
+		go p.work()
+	}
+	return p
+}
+
+// Submit queues a task and returns its job
+func (p *Pool[T]) Submit(task Task[T]) (Job[T], error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.closed {
+		return Job[T]{}, ErrClosed
+	}
+	p.forgetFinished()
+
+	j := &job[T]{
+		Job: Job[T]{
+			ID:        uuid.New().String(),
+			Status:    Queued,
+			Submitted: time.Now(),
+		},
+		task: task,
+		done: make(chan struct{}),
+	}
+	select {
+	case p.queue <- j:
+	default:
+		return Job[T]{}, ErrQueueFull
+	}
+	p.jobs[j.ID] = j
+	return j.Job, nil
+}
+
+// Get returns the current state of a job
+func (p *Pool[T]) Get(id string) (Job[T], error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	j, ok := p.jobs[id]
+	if !ok {
+		return Job[T]{}, ErrNotFound
+	}
+	return j.Job, nil
+}
+
+// Cancel cancels a job. A queued job is cancelled at once; a running job is
+// cancelled once its task returns, which it should do soon after its context
+// is cancelled. Finished jobs are left unchanged.
+func (p *Pool[T]) Cancel(id string) (Job[T], error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	j, ok := p.jobs[id]
+	if !ok {
+		return Job[T]{}, ErrNotFound
+	}
+	switch j.Status {
+	case Queued:
+		j.finish(Cancelled)
+	case Running:
+		j.cancelled = true
+		j.cancel()
+	}
+	return j.Job, nil
+}
+
+// Wait waits until a job has finished or ctx is done and returns its state
+// at that time
+func (p *Pool[T]) Wait(ctx context.Context, id string) (Job[T], error) {
+	p.mu.Lock()
+	j, ok := p.jobs[id]
+	p.mu.Unlock()
+	if !ok {
+		return Job[T]{}, ErrNotFound
+	}
+
+	select {
+	case <-j.done:
+	case <-ctx.Done():
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return j.Job, nil
+}
+
+// Close stops accepting jobs, cancels the queued and running ones and waits
+// for the workers to stop
+func (p *Pool[T]) Close() {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return
+	}
+	p.closed = true
+	close(p.queue)
+	p.mu.Unlock()
+
+	p.stop()
+	p.wg.Wait()
+}
+
+// work performs queued jobs until the queue is closed
+func (p *Pool[T]) work() {
+	defer p.wg.Done()
+
+	for j := range p.queue {
+		p.mu.Lock()
+		if j.Status != Queued {
+			p.mu.Unlock()
+			continue
+		}
+		if p.ctx.Err() != nil {
+			j.finish(Cancelled)
+			p.mu.Unlock()
+			continue
+		}
+		ctx, cancel := context.WithCancel(p.ctx)
+		j.Status = Running
+		j.Started = time.Now()
+		j.cancel = cancel
+		p.mu.Unlock()
+
+		result, err := run(ctx, j.task)
+		cancel()
+
+		p.mu.Lock()
+		j.Result = result
+		switch {
+		case j.cancelled || p.ctx.Err() != nil:
+			j.finish(Cancelled)
+		case err != nil:
+			j.finish(Failed)
+		default:
+			j.finish(Done)
+		}
+		p.mu.Unlock()
+	}
+}
+
+// run performs a task, turning a panic into an error so that the job fails
+// instead of the worker
+func run[T any](ctx context.Context, task Task[T]) (result T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			var zero T
+			result, err = zero, fmt.Errorf("%w: %v", ErrPanicked, r)
+		}
+	}()
+	return task(ctx)
+}
+
+// finish moves the job to a final status
+func (j *job[T]) finish(status Status) {
+	j.Status = status
+	j.Finished = time.Now()
+	j.task = nil
+	close(j.done)
+}
+
+// forgetFinished drops the jobs that finished longer than the retention ago
+func (p *Pool[T]) forgetFinished() {
+	cutoff := time.Now().Add(-p.retention)
+	for id, j := range p.jobs {
+		if j.Status.Finished() && j.Finished.Before(cutoff) {
+			delete(p.jobs, id)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+// Package money provides ISO 4217 currencies and exchange rate conversion
+// for exact monetary amounts.
+package money
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/yourusername/proto-buf-experiment/services/calculation/internal/decimal"
+)
+
+// Currency is an ISO 4217 currency with the number of digits of its minor
+// unit, e.g. 2 for cents
+type Currency struct {
+	Code       string
+	MinorUnits int
+}
+
+// UnknownCurrencyError is returned for codes that are not known
+type UnknownCurrencyError struct {
+	Code string
+}
+
+func (e *UnknownCurrencyError) Error() string {
+	return fmt.Sprintf("unknown currency %q", e.Code)
+}
+
+// PrecisionError is returned for amounts finer than the minor unit
+type PrecisionError struct {
+	Amount   string
+	Currency Currency
+}
+
+func (e *PrecisionError) Error() string {
+	return fmt.Sprintf("amount %s has more than %d fractional digits allowed for %s",
+		e.Amount, e.Currency.MinorUnits, e.Currency.Code)
+}
+
+// LookupCurrency returns the currency with the given code, ignoring case
+func LookupCurrency(code string) (Currency, error) {
+	currency, ok := currencies[strings.ToUpper(code)]
+	if !ok {
+		return Currency{}, &UnknownCurrencyError{Code: code}
+	}
+	return currency, nil
+}
+
+// ParseAmount parses a decimal amount in the given currency, rejecting
+// amounts with more fractional digits than its minor unit
+func ParseAmount(text string, currency Currency) (*big.Rat, error) {
+	amount, err := decimal.Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	if scale, _ := decimal.Scale(amount); scale > currency.MinorUnits {
+		return nil, &PrecisionError{Amount: text, Currency: currency}
+	}
+	return amount, nil
+}
+
+// currencies lists the supported ISO 4217 currencies by code
+var currencies = func() map[string]Currency {
+	m := make(map[string]Currency)
+	for _, c := range []Currency{
+		{Code: "AUD", MinorUnits: 2},
+		{Code: "BHD", MinorUnits: 3},
+		{Code: "BRL", MinorUnits: 2},
+		{Code: "CAD", MinorUnits: 2},
+		{Code: "CHF", MinorUnits: 2},
+		{Code: "CLP", MinorUnits: 0},
+		{Code: "CNY", MinorUnits: 2},
+		{Code: "CZK", MinorUnits: 2},
+		{Code: "DKK", MinorUnits: 2},
+		{Code: "EUR", MinorUnits: 2},
+		{Code: "GBP", MinorUnits: 2},
+		{Code: "HKD", MinorUnits: 2},
+		{Code: "HUF", MinorUnits: 2},
+		{Code: "INR", MinorUnits: 2},
+		{Code: "ISK", MinorUnits: 0},
+		{Code: "JOD", MinorUnits: 3},
+		{Code: "JPY", MinorUnits: 0},
+		{Code: "KRW", MinorUnits: 0},
+		{Code: "KWD", MinorUnits: 3},
+		{Code: "MXN", MinorUnits: 2},
+		{Code: "NOK", MinorUnits: 2},
+		{Code: "NZD", MinorUnits: 2},
+		{Code: "OMR", MinorUnits: 3},
+		{Code: "PLN", MinorUnits: 2},
+		{Code: "SEK", MinorUnits: 2},
+		{Code: "SGD", MinorUnits: 2},
+		{Code: "TND", MinorUnits: 3},
+		{Code: "USD", MinorUnits: 2},
+		{Code: "VND", MinorUnits: 0},
+		{Code: "ZAR", MinorUnits: 2},
+	} {
+		m[c.Code] = c
+	}
+	return m
+}()
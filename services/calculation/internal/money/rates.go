@@ -0,0 +1,110 @@
+package money
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"sync"
+
+	"github.com/yourusername/proto-buf-experiment/services/calculation/internal/decimal"
+)
+
+// MissingRateError is returned when no rate is known for a conversion
+type MissingRateError struct {
+	From, To string
+}
+
+func (e *MissingRateError) Error() string {
+	return fmt.Sprintf("no exchange rate from %s to %s", e.From, e.To)
+}
+
+// ratesFile is the on-disk format of an exchange rate table, e.g.
+//
+//	{"base": "EUR", "rates": {"USD": "1.0850", "GBP": "0.8575"}}
+//
+// Each rate is the amount of that currency worth one unit of base.
+type ratesFile struct {
+	Base  string            `json:"base"`
+	Rates map[string]string `json:"rates"`
+}
+
+// Rates is an exchange rate table relative to a base currency
+type Rates struct {
+	mu    sync.RWMutex
+	base  string
+	rates map[string]*big.Rat
+}
+
+// NewRates creates an empty rate table
+func NewRates() *Rates {
+	return &Rates{rates: make(map[string]*big.Rat)}
+}
+
+// LoadFile replaces the table with the rates read from a JSON file
+func (r *Rates) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("open exchange rates: %w", err)
+	}
+	defer f.Close()
+	return r.Load(f)
+}
+
+// Load replaces the table with the JSON rates read from reader
+func (r *Rates) Load(reader io.Reader) error {
+	var file ratesFile
+	if err := json.NewDecoder(reader).Decode(&file); err != nil {
+		return fmt.Errorf("decode exchange rates: %w", err)
+	}
+
+	base, err := LookupCurrency(file.Base)
+	if err != nil {
+		return fmt.Errorf("exchange rates base: %w", err)
+	}
+
+	rates := map[string]*big.Rat{base.Code: big.NewRat(1, 1)}
+	for code, text := range file.Rates {
+		currency, err := LookupCurrency(code)
+		if err != nil {
+			return fmt.Errorf("exchange rates: %w", err)
+		}
+		rate, err := decimal.Parse(text)
+		if err != nil {
+			return fmt.Errorf("exchange rate for %s: %w", currency.Code, err)
+		}
+		if rate.Sign() <= 0 {
+			return fmt.Errorf("exchange rate for %s must be positive", currency.Code)
+		}
+		rates[currency.Code] = rate
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.base = base.Code
+	r.rates = rates
+	return nil
+}
+
+// Convert converts an amount between currencies through the base currency.
+// The result is exact and must be rounded to the target minor unit.
+func (r *Rates) Convert(amount *big.Rat, from, to Currency) (*big.Rat, error) {
+	if from.Code == to.Code {
+		return new(big.Rat).Set(amount), nil
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	fromRate, ok := r.rates[from.Code]
+	if !ok {
+		return nil, &MissingRateError{From: from.Code, To: to.Code}
+	}
+	toRate, ok := r.rates[to.Code]
+	if !ok {
+		return nil, &MissingRateError{From: from.Code, To: to.Code}
+	}
+
+	converted := new(big.Rat).Quo(amount, fromRate)
+	return converted.Mul(converted, toRate), nil
+}
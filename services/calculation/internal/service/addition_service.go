@@ -4,99 +4,216 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"runtime"
+	"strconv"
+	"strings"
 
 	"github.com/google/uuid"
+	"github.com/rs/zerolog"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/internal/history"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/internal/idempotency"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/internal/jobs"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/internal/money"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/internal/summation"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/internal/units"
 )
 
+// summationAlgorithm pairs a summation function with the calculation method
+// reported in the response metadata
+type summationAlgorithm struct {
+	sum    func(context.Context, []float64) (float64, error)
+	method string
+}
+
+// summationAlgorithms maps the requested algorithm to its implementation
+var summationAlgorithms = map[pb.SummationAlgorithm]summationAlgorithm{
+	pb.SummationAlgorithm_SUMMATION_ALGORITHM_UNSPECIFIED: {sum: summation.Naive, method: "simple_addition"},
+	pb.SummationAlgorithm_SUMMATION_ALGORITHM_NAIVE:       {sum: summation.Naive, method: "simple_addition"},
+	pb.SummationAlgorithm_SUMMATION_ALGORITHM_KAHAN:       {sum: summation.Kahan, method: "kahan_summation"},
+	pb.SummationAlgorithm_SUMMATION_ALGORITHM_NEUMAIER:    {sum: summation.Neumaier, method: "neumaier_summation"},
+	pb.SummationAlgorithm_SUMMATION_ALGORITHM_PAIRWISE:    {sum: summation.Pairwise, method: "pairwise_summation"},
+	pb.SummationAlgorithm_SUMMATION_ALGORITHM_PARALLEL:    {sum: summation.Parallel, method: "parallel_pairwise_summation"},
+}
+
+// parallelThreshold is the number of operands from which additions that do
+// not request an algorithm are performed by PARALLEL
+const parallelThreshold = 1 << 20
+
+// selectAlgorithm returns the implementation of the requested summation
+// algorithm for count operands. It reports false for an unknown algorithm.
+func selectAlgorithm(requested pb.SummationAlgorithm, count int) (summationAlgorithm, bool) {
+	if requested == pb.SummationAlgorithm_SUMMATION_ALGORITHM_UNSPECIFIED && count >= parallelThreshold {
+		requested = pb.SummationAlgorithm_SUMMATION_ALGORITHM_PARALLEL
+	}
+	algorithm, ok := summationAlgorithms[requested]
+	return algorithm, ok
+}
+
 // AdditionService implements the AdditionService interface
 type AdditionService struct {
 	pb.UnimplementedAdditionServiceServer
+
+	// units resolves the units of unit-aware additions
+	units *units.Registry
+
+	// rates converts money operands between currencies
+	rates *money.Rates
+
+	// responses remembers the responses of additions by request ID
+	responses *idempotency.Store[storedResponse]
+
+	// history records the calculations performed
+	history history.Repository
+
+	// recorder saves calculations in the history off the request path
+	recorder *recorder
+
+	// logger reports calculations that could not be recorded
+	logger zerolog.Logger
+
+	// jobs performs submitted calculations in the background
+	jobs *jobs.Pool[jobOutcome]
 }
 
 // NewAdditionService creates a new instance of AdditionService
 func NewAdditionService() *AdditionService {
-	return &AdditionService{}
+	s := &AdditionService{
+		units:     units.NewRegistry(),
+		rates:     money.NewRates(),
+		responses: idempotency.NewStore[storedResponse](idempotencyCapacity, idempotencyTTL),
+		history:   history.NewMemoryRepository(historyMemory),
+		recorder:  newRecorder(recordQueueSize),
+		logger:    zerolog.Nop(),
+		jobs:      jobs.NewPool[jobOutcome](runtime.GOMAXPROCS(0), jobQueueSize, jobRetention),
+	}
+	go s.saveRecords()
+	return s
 }
 
-// Add performs addition of numbers in the request
+// Add performs addition of numbers in the request. A request retried with a
+// request ID that was already answered gets the stored response.
 func (s *AdditionService) Add(ctx context.Context, req *pb.AddRequest) (*pb.AddResponse, error) {
+	return idempotent(s, ctx, "add", req, s.add)
+}
+
+// add performs addition of numbers in the request
+func (s *AdditionService) add(ctx context.Context, req *pb.AddRequest) (*pb.AddResponse, error) {
 	// Validate request ID
 	requestID := req.RequestId
 	if requestID == "" {
 		requestID = uuid.New().String()
 	}
 
-	// Validate constraints if provided
-	if req.Constraints != nil {
-		// Check max number of numbers
-		if req.Constraints.MaxNumbers != nil && len(req.Numbers) > int(*req.Constraints.MaxNumbers) {
-			return &pb.AddResponse{
-				RequestId: requestID,
-				Error: &pb.AddResponse_ErrorInfo{
-					Code:     "CONSTRAINT_VIOLATION",
-					Message:  fmt.Sprintf("Too many numbers. Maximum allowed: %d", *req.Constraints.MaxNumbers),
-					Severity: pb.AddResponse_ErrorInfo_SEVERITY_WARNING,
-				},
-			}, fmt.Errorf("too many numbers")
-		}
-
-		// Validate min and max values
-		for _, num := range req.Numbers {
-			if req.Constraints.MinValue != nil && num < *req.Constraints.MinValue {
-				return &pb.AddResponse{
-					RequestId: requestID,
-					Error: &pb.AddResponse_ErrorInfo{
-						Code:     "VALUE_TOO_LOW",
-						Message:  fmt.Sprintf("Number %f is below minimum %f", num, *req.Constraints.MinValue),
-						Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
-					},
-				}, fmt.Errorf("number below minimum")
-			}
-
-			if req.Constraints.MaxValue != nil && num > *req.Constraints.MaxValue {
-				return &pb.AddResponse{
-					RequestId: requestID,
-					Error: &pb.AddResponse_ErrorInfo{
-						Code:     "VALUE_TOO_HIGH",
-						Message:  fmt.Sprintf("Number %f is above maximum %f", num, *req.Constraints.MaxValue),
-						Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
-					},
-				}, fmt.Errorf("number above maximum")
-			}
-		}
+	// Only one operand variant can be used per request
+	if operandKinds(req) > 1 {
+		return &pb.AddResponse{
+			RequestId: requestID,
+			Error: &pb.AddResponse_ErrorInfo{
+				Code:     "MIXED_OPERANDS",
+				Message:  "Only one operand variant can be used per request",
+				Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+			},
+		}, fmt.Errorf("mixed operands")
 	}
 
-	// Validate request
-	if len(req.Numbers) == 0 {
+	// Units can only be attached to double operands
+	if (len(req.Units) > 0 || req.ResultUnit != nil) && len(req.Numbers) == 0 && operandKinds(req) > 0 {
 		return &pb.AddResponse{
 			RequestId: requestID,
 			Error: &pb.AddResponse_ErrorInfo{
-				Code:     "NO_NUMBERS",
-				Message:  "No numbers provided for addition",
-				Severity: pb.AddResponse_ErrorInfo_SEVERITY_WARNING,
+				Code:     "INVALID_UNITS",
+				Message:  "Units are only supported for numbers",
+				Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
 			},
-		}, fmt.Errorf("no numbers provided")
+		}, fmt.Errorf("units without numbers")
 	}
 
-	// Perform addition
-	var result float64
-	for _, num := range req.Numbers {
-		result += num
+	// Decimal, integer, money and fraction operands are added exactly
+	if len(req.DecimalNumbers) > 0 {
+		return s.addDecimal(requestID, req)
+	}
+	if len(req.IntegerNumbers) > 0 || len(req.BigIntegerNumbers) > 0 {
+		return s.addInteger(requestID, req)
+	}
+	if len(req.MoneyNumbers) > 0 {
+		return s.addMoney(requestID, req)
+	}
+	if len(req.FractionNumbers) > 0 {
+		return s.addFractions(requestID, req)
+	}
+
+	// Complex operands are added part by part
+	if len(req.ComplexNumbers) > 0 {
+		return s.addComplex(ctx, requestID, req)
+	}
+
+	// Interval operands are added with outward rounding
+	if len(req.IntervalNumbers) > 0 {
+		return s.addIntervals(requestID, req)
+	}
+
+	// Numbers carrying units are converted before adding
+	if len(req.Units) > 0 || req.ResultUnit != nil {
+		return s.addQuantities(ctx, requestID, req)
+	}
+
+	// Validate numbers against constraints
+	operands, errInfo, err := validateNumbers(req.Numbers, req.Constraints)
+	if err != nil {
+		return &pb.AddResponse{
+			RequestId: requestID,
+			Error:     errInfo,
+		}, err
 	}
+	numbers := operands.numbers
 
-	// Check for overflow
-	if math.IsInf(result, 0) {
+	// Select summation algorithm
+	algorithm, ok := selectAlgorithm(req.Algorithm, len(numbers))
+	if !ok {
 		return &pb.AddResponse{
 			RequestId: requestID,
 			Error: &pb.AddResponse_ErrorInfo{
-				Code:     "OVERFLOW",
-				Message:  "Calculation resulted in infinity",
-				Severity: pb.AddResponse_ErrorInfo_SEVERITY_CRITICAL,
+				Code:     "INVALID_ALGORITHM",
+				Message:  fmt.Sprintf("Unsupported summation algorithm %s", req.Algorithm),
+				Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
 			},
-		}, fmt.Errorf("calculation overflow")
+		}, fmt.Errorf("invalid summation algorithm")
+	}
+
+	// Perform addition, stopping early if the call is cancelled
+	result, err := algorithm.sum(ctx, numbers)
+	if err != nil {
+		errInfo, err := cancelledError(err)
+		return &pb.AddResponse{
+			RequestId: requestID,
+			Error:     errInfo,
+		}, err
+	}
+
+	// Check for overflow and undefined results
+	if errInfo, err := checkResult(result, numbers); err != nil {
+		return &pb.AddResponse{
+			RequestId: requestID,
+			Error:     errInfo,
+		}, err
+	}
+
+	// Validate the sum against constraints
+	warnings := operands.warnings
+	if req.Constraints != nil {
+		if errInfo, err := checkSum(result, req.Constraints); err != nil {
+			warning, ok := warnOnly(errInfo, req.Constraints)
+			if !ok {
+				return &pb.AddResponse{
+					RequestId: requestID,
+					Error:     errInfo,
+				}, err
+			}
+			warnings = append(warnings, warning)
+		}
 	}
 
 	// Prepare response with calculation metadata
@@ -105,8 +222,483 @@ func (s *AdditionService) Add(ctx context.Context, req *pb.AddRequest) (*pb.AddR
 		RequestId: requestID,
 		CalculationMetadata: &pb.AddResponse_CalculationMetadata{
 			CalculationTime:   timestamppb.Now(),
-			NumbersProcessed:  int32(len(req.Numbers)),
-			CalculationMethod: "simple_addition",
+			NumbersProcessed:  int32(len(numbers)),
+			ClampedCount:      int32(operands.clamped),
+			DroppedCount:      int32(operands.dropped),
+			CalculationMethod: algorithm.method,
+			Warnings:          warnings,
 		},
 	}, nil
 }
+
+// operandKinds returns how many operand variants the request sets
+func operandKinds(req *pb.AddRequest) int {
+	kinds := 0
+	if len(req.Numbers) > 0 {
+		kinds++
+	}
+	if len(req.DecimalNumbers) > 0 {
+		kinds++
+	}
+	if len(req.IntegerNumbers) > 0 || len(req.BigIntegerNumbers) > 0 {
+		kinds++
+	}
+	if len(req.MoneyNumbers) > 0 {
+		kinds++
+	}
+	if len(req.FractionNumbers) > 0 {
+		kinds++
+	}
+	if len(req.ComplexNumbers) > 0 {
+		kinds++
+	}
+	if len(req.IntervalNumbers) > 0 {
+		kinds++
+	}
+	return kinds
+}
+
+// validateNumbers checks the operands against the optional constraints and
+// rejects empty input. It is shared by every arithmetic operation and returns
+// the operands left after applying the NaN and infinity policies and the
+// constraint actions. In collect-all validation mode every violation is
+// reported at once.
+func validateNumbers(numbers []float64, constraints *pb.AddRequest_Constraints) (validated, *pb.AddResponse_ErrorInfo, error) {
+	// Validate request
+	if len(numbers) == 0 {
+		errInfo, err := noNumbersError()
+		return validated{}, errInfo, err
+	}
+
+	// Validate constraint actions
+	if errInfo, err := checkActions(constraints); err != nil {
+		return validated{}, errInfo, err
+	}
+
+	collected := &violations{collectAll: constraints.GetValidationMode() == pb.ValidationMode_VALIDATION_MODE_COLLECT_ALL}
+	dropExtra := constraints.GetMaxNumbersAction() == pb.ConstraintAction_CONSTRAINT_ACTION_DROP
+	var warnings []*pb.AddResponse_ErrorInfo
+
+	// Check min and max number of numbers
+	if constraints != nil {
+		if errInfo, err := checkMinNumbers(len(numbers), constraints); err != nil {
+			if warning, ok := warnOnly(errInfo, constraints); ok {
+				warnings = append(warnings, warning)
+			} else if !collected.add(-1, 0, errInfo) {
+				return validated{}, errInfo, err
+			}
+		}
+		if errInfo, err := checkMaxNumbers(len(numbers), constraints); err != nil && !dropExtra {
+			first := max(int(constraints.GetMaxNumbers()), 0)
+			if !collected.add(first, numbers[first], errInfo) {
+				return validated{}, errInfo, err
+			}
+		}
+	}
+
+	kept := validated{
+		numbers:   make([]float64, 0, len(numbers)),
+		positions: make([]int, 0, len(numbers)),
+		warnings:  warnings,
+	}
+	seen := make(map[float64]int)
+	for i, num := range numbers {
+		// Apply finite_only and the NaN and infinity policies
+		keep, errInfo, err := checkNonFinite(num, i, constraints)
+		if err != nil {
+			if !collected.add(i, num, errInfo) {
+				return validated{}, errInfo, err
+			}
+			continue
+		}
+		if !keep {
+			if finiteOnlyDrops(num, constraints) {
+				kept.dropped++
+			}
+			continue
+		}
+
+		// Validate the value of the number, clamping or dropping it if
+		// the violated constraint says so
+		if constraints != nil {
+			value, action, errInfo, err := resolveOperand(num, constraints)
+			if err != nil {
+				if !collected.add(i, num, errInfo) {
+					return validated{}, errInfo, err
+				}
+				continue
+			}
+			switch action {
+			case pb.ConstraintAction_CONSTRAINT_ACTION_DROP:
+				kept.dropped++
+				continue
+			case pb.ConstraintAction_CONSTRAINT_ACTION_CLAMP:
+				kept.clamped++
+			}
+			num = value
+		}
+
+		// Validate uniqueness
+		if constraints.GetUnique() {
+			if previous, ok := seen[num]; ok {
+				if constraints.GetUniqueAction() == pb.ConstraintAction_CONSTRAINT_ACTION_DROP {
+					kept.dropped++
+					continue
+				}
+				errInfo, err := duplicateValueError(num, i, previous)
+				if !collected.add(i, num, errInfo) {
+					return validated{}, errInfo, err
+				}
+				continue
+			}
+			seen[num] = i
+		}
+
+		kept.numbers = append(kept.numbers, num)
+		kept.positions = append(kept.positions, i)
+	}
+
+	if errInfo, err := collected.result(); err != nil {
+		return validated{}, errInfo, err
+	}
+
+	// Drop the operands beyond the maximum number of numbers
+	if limit := int(constraints.GetMaxNumbers()); dropExtra && constraints.MaxNumbers != nil && len(kept.numbers) > limit {
+		limit = max(limit, 0)
+		kept.dropped += len(kept.numbers) - limit
+		kept.numbers = kept.numbers[:limit]
+		kept.positions = kept.positions[:limit]
+	}
+
+	// Every operand may have been skipped or dropped
+	if len(kept.numbers) == 0 {
+		errInfo, err := noNumbersError()
+		return validated{}, errInfo, err
+	}
+
+	return kept, nil, nil
+}
+
+// violationRules maps the error codes of operand checks to the constraint
+// reported as the rule of a violation
+var violationRules = map[string]string{
+	"CONSTRAINT_VIOLATION": "max_numbers",
+	"VALUE_TOO_LOW":        "min_value",
+	"VALUE_TOO_HIGH":       "max_value",
+	"NAN_INPUT":            "nan_policy",
+	"INFINITE_INPUT":       "infinity_policy",
+	"TOO_FEW_NUMBERS":      "min_numbers",
+	"NOT_FINITE":           "finite_only",
+	"NEGATIVE_VALUE":       "non_negative",
+	"NOT_INTEGER":          "integer_only",
+	"TOO_MANY_DECIMALS":    "max_decimal_places",
+	"DUPLICATE_VALUE":      "unique",
+}
+
+// maxViolations caps the violations listed in a response, which travel in
+// the gRPC status of the failed call
+const maxViolations = 1000
+
+// violations collects the constraint violations of a request in collect-all
+// validation mode
+type violations struct {
+	collectAll bool
+	count      int
+	list       []*pb.AddResponse_ErrorInfo_Violation
+}
+
+// add records the violation of the operand at index. It returns false in
+// fail-fast mode, where validation stops at the first violation.
+func (v *violations) add(index int, value float64, errInfo *pb.AddResponse_ErrorInfo) bool {
+	if !v.collectAll {
+		return false
+	}
+	v.count++
+	if len(v.list) == maxViolations {
+		return true
+	}
+	v.list = append(v.list, &pb.AddResponse_ErrorInfo_Violation{
+		Index:   int32(index),
+		Value:   value,
+		Rule:    violationRules[errInfo.Code],
+		Code:    errInfo.Code,
+		Message: errInfo.Message,
+	})
+	return true
+}
+
+// result describes every collected violation, if there are any
+func (v *violations) result() (*pb.AddResponse_ErrorInfo, error) {
+	if v.count == 0 {
+		return nil, nil
+	}
+	message := fmt.Sprintf("Found %d constraint violations", v.count)
+	if v.count > len(v.list) {
+		message += fmt.Sprintf(", listing the first %d", len(v.list))
+	}
+	return &pb.AddResponse_ErrorInfo{
+		Code:       "CONSTRAINT_VIOLATIONS",
+		Message:    message,
+		Severity:   pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+		Violations: v.list,
+	}, fmt.Errorf("constraint violations")
+}
+
+// checkNonFinite applies finite_only and the NaN and infinity policies to the
+// operand at position. It reports whether the operand is kept, which finite
+// operands always are.
+func checkNonFinite(num float64, position int, constraints *pb.AddRequest_Constraints) (bool, *pb.AddResponse_ErrorInfo, error) {
+	// Finite-only overrides the NaN and infinity policies
+	if finiteOnlyDrops(num, constraints) {
+		return false, nil, nil
+	}
+	if constraints.GetFiniteOnly() && (math.IsNaN(num) || math.IsInf(num, 0)) {
+		errInfo, err := notFiniteError(num, position)
+		return false, errInfo, err
+	}
+
+	switch policy := nonFinitePolicy(num, constraints); policy {
+	case pb.NonFinitePolicy_NON_FINITE_POLICY_PROPAGATE:
+		return true, nil, nil
+	case pb.NonFinitePolicy_NON_FINITE_POLICY_SKIP:
+		return false, nil, nil
+	case pb.NonFinitePolicy_NON_FINITE_POLICY_UNSPECIFIED, pb.NonFinitePolicy_NON_FINITE_POLICY_REJECT:
+		errInfo, err := nonFiniteInputError(num, position)
+		return false, errInfo, err
+	default:
+		errInfo, err := invalidPolicyError(policy)
+		return false, errInfo, err
+	}
+}
+
+// finiteOnlyDrops reports whether finite_only drops the operand, which counts
+// towards dropped_count unlike operands skipped by the NaN and infinity
+// policies
+func finiteOnlyDrops(num float64, constraints *pb.AddRequest_Constraints) bool {
+	return constraints.GetFiniteOnly() && (math.IsNaN(num) || math.IsInf(num, 0)) &&
+		constraints.GetFiniteOnlyAction() == pb.ConstraintAction_CONSTRAINT_ACTION_DROP
+}
+
+// nonFinitePolicy returns the policy for a NaN or infinite operand. Finite
+// operands are always kept, as if propagated.
+func nonFinitePolicy(num float64, constraints *pb.AddRequest_Constraints) pb.NonFinitePolicy {
+	switch {
+	case math.IsNaN(num):
+		return constraints.GetNanPolicy()
+	case math.IsInf(num, 0):
+		return constraints.GetInfinityPolicy()
+	default:
+		return pb.NonFinitePolicy_NON_FINITE_POLICY_PROPAGATE
+	}
+}
+
+// invalidPolicyError describes an unknown non-finite policy
+func invalidPolicyError(policy pb.NonFinitePolicy) (*pb.AddResponse_ErrorInfo, error) {
+	return &pb.AddResponse_ErrorInfo{
+		Code:     "INVALID_POLICY",
+		Message:  fmt.Sprintf("Unsupported non-finite policy %s", policy),
+		Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+	}, fmt.Errorf("invalid non-finite policy")
+}
+
+// nonFiniteInputError describes a rejected NaN or infinite operand
+func nonFiniteInputError(num float64, position int) (*pb.AddResponse_ErrorInfo, error) {
+	if math.IsNaN(num) {
+		return &pb.AddResponse_ErrorInfo{
+			Code:     "NAN_INPUT",
+			Message:  fmt.Sprintf("Number at position %d is NaN", position),
+			Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+		}, fmt.Errorf("nan input")
+	}
+	return &pb.AddResponse_ErrorInfo{
+		Code:     "INFINITE_INPUT",
+		Message:  fmt.Sprintf("Number at position %d is %v", position, num),
+		Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+	}, fmt.Errorf("infinite input")
+}
+
+// checkResult rejects a NaN or infinite result of finite operands. Results of
+// propagated non-finite operands are returned as they are.
+func checkResult(result float64, numbers []float64) (*pb.AddResponse_ErrorInfo, error) {
+	if !math.IsInf(result, 0) && !math.IsNaN(result) {
+		return nil, nil
+	}
+	for _, num := range numbers {
+		if math.IsInf(num, 0) || math.IsNaN(num) {
+			return nil, nil
+		}
+	}
+	if math.IsNaN(result) {
+		return nanResultError()
+	}
+	return overflowError()
+}
+
+// checkMaxNumbers rejects more operands than the constraints allow
+func checkMaxNumbers(count int, constraints *pb.AddRequest_Constraints) (*pb.AddResponse_ErrorInfo, error) {
+	if constraints.MaxNumbers != nil && count > int(*constraints.MaxNumbers) {
+		return &pb.AddResponse_ErrorInfo{
+			Code:     "CONSTRAINT_VIOLATION",
+			Message:  fmt.Sprintf("Too many numbers. Maximum allowed: %d", *constraints.MaxNumbers),
+			Severity: pb.AddResponse_ErrorInfo_SEVERITY_WARNING,
+		}, fmt.Errorf("too many numbers")
+	}
+	return nil, nil
+}
+
+// checkRange rejects an operand outside the min and max values
+func checkRange(num float64, constraints *pb.AddRequest_Constraints) (*pb.AddResponse_ErrorInfo, error) {
+	if constraints.MinValue != nil && num < *constraints.MinValue {
+		return &pb.AddResponse_ErrorInfo{
+			Code:     "VALUE_TOO_LOW",
+			Message:  fmt.Sprintf("Number %f is below minimum %f", num, *constraints.MinValue),
+			Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+		}, fmt.Errorf("number below minimum")
+	}
+
+	if constraints.MaxValue != nil && num > *constraints.MaxValue {
+		return &pb.AddResponse_ErrorInfo{
+			Code:     "VALUE_TOO_HIGH",
+			Message:  fmt.Sprintf("Number %f is above maximum %f", num, *constraints.MaxValue),
+			Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+		}, fmt.Errorf("number above maximum")
+	}
+
+	return nil, nil
+}
+
+// checkMinNumbers rejects fewer operands than the constraints require
+func checkMinNumbers(count int, constraints *pb.AddRequest_Constraints) (*pb.AddResponse_ErrorInfo, error) {
+	if constraints.MinNumbers != nil && count < int(*constraints.MinNumbers) {
+		return &pb.AddResponse_ErrorInfo{
+			Code:     "TOO_FEW_NUMBERS",
+			Message:  fmt.Sprintf("Too few numbers. Minimum required: %d", *constraints.MinNumbers),
+			Severity: pb.AddResponse_ErrorInfo_SEVERITY_WARNING,
+		}, fmt.Errorf("too few numbers")
+	}
+	return nil, nil
+}
+
+// checkOperand rejects an operand violating any of the per-number
+// constraints
+func checkOperand(num float64, constraints *pb.AddRequest_Constraints) (*pb.AddResponse_ErrorInfo, error) {
+	if errInfo, err := checkRange(num, constraints); err != nil {
+		return errInfo, err
+	}
+
+	if constraints.NonNegative && num < 0 {
+		return &pb.AddResponse_ErrorInfo{
+			Code:     "NEGATIVE_VALUE",
+			Message:  fmt.Sprintf("Number %v is negative", num),
+			Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+		}, fmt.Errorf("negative number")
+	}
+
+	// Fractional digits are only checked for finite numbers
+	if math.IsNaN(num) || math.IsInf(num, 0) {
+		return nil, nil
+	}
+
+	if constraints.IntegerOnly && num != math.Trunc(num) {
+		return &pb.AddResponse_ErrorInfo{
+			Code:     "NOT_INTEGER",
+			Message:  fmt.Sprintf("Number %v is not an integer", num),
+			Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+		}, fmt.Errorf("number not an integer")
+	}
+
+	if constraints.MaxDecimalPlaces != nil && decimalPlaces(num) > int(*constraints.MaxDecimalPlaces) {
+		return &pb.AddResponse_ErrorInfo{
+			Code:     "TOO_MANY_DECIMALS",
+			Message:  fmt.Sprintf("Number %v has more than %d decimal places", num, *constraints.MaxDecimalPlaces),
+			Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+		}, fmt.Errorf("too many decimal places")
+	}
+
+	return nil, nil
+}
+
+// decimalPlaces counts the fractional digits of the shortest decimal
+// representation of num
+func decimalPlaces(num float64) int {
+	text := strconv.FormatFloat(num, 'f', -1, 64)
+	if dot := strings.IndexByte(text, '.'); dot >= 0 {
+		return len(text) - dot - 1
+	}
+	return 0
+}
+
+// checkSum rejects a sum outside the min and max sum
+func checkSum(sum float64, constraints *pb.AddRequest_Constraints) (*pb.AddResponse_ErrorInfo, error) {
+	if constraints.MinSum != nil && sum < *constraints.MinSum {
+		return &pb.AddResponse_ErrorInfo{
+			Code:     "SUM_TOO_LOW",
+			Message:  fmt.Sprintf("Sum %f is below minimum %f", sum, *constraints.MinSum),
+			Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+		}, fmt.Errorf("sum below minimum")
+	}
+
+	if constraints.MaxSum != nil && sum > *constraints.MaxSum {
+		return &pb.AddResponse_ErrorInfo{
+			Code:     "SUM_TOO_HIGH",
+			Message:  fmt.Sprintf("Sum %f is above maximum %f", sum, *constraints.MaxSum),
+			Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+		}, fmt.Errorf("sum above maximum")
+	}
+
+	return nil, nil
+}
+
+// notFiniteError describes a NaN or infinite operand rejected by finite-only
+func notFiniteError(num float64, position int) (*pb.AddResponse_ErrorInfo, error) {
+	return &pb.AddResponse_ErrorInfo{
+		Code:     "NOT_FINITE",
+		Message:  fmt.Sprintf("Number at position %d is %v, only finite numbers are allowed", position, num),
+		Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+	}, fmt.Errorf("number not finite")
+}
+
+// duplicateValueError describes an operand that occurred before
+func duplicateValueError(num float64, position, previous int) (*pb.AddResponse_ErrorInfo, error) {
+	return &pb.AddResponse_ErrorInfo{
+		Code:     "DUPLICATE_VALUE",
+		Message:  fmt.Sprintf("Number %v at position %d duplicates position %d", num, position, previous),
+		Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+	}, fmt.Errorf("duplicate number")
+}
+
+// noNumbersError describes a request without operands
+func noNumbersError() (*pb.AddResponse_ErrorInfo, error) {
+	return &pb.AddResponse_ErrorInfo{
+		Code:     "NO_NUMBERS",
+		Message:  "No numbers provided for calculation",
+		Severity: pb.AddResponse_ErrorInfo_SEVERITY_WARNING,
+	}, fmt.Errorf("no numbers provided")
+}
+
+// cancelledError describes a calculation stopped because its context ended
+func cancelledError(err error) (*pb.AddResponse_ErrorInfo, error) {
+	return &pb.AddResponse_ErrorInfo{
+		Code:     "REQUEST_CANCELLED",
+		Message:  "Calculation was cancelled before it finished",
+		Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+	}, err
+}
+
+// nanResultError describes a calculation that resulted in NaN
+func nanResultError() (*pb.AddResponse_ErrorInfo, error) {
+	return &pb.AddResponse_ErrorInfo{
+		Code:     "NAN_RESULT",
+		Message:  "Calculation resulted in NaN",
+		Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+	}, fmt.Errorf("calculation resulted in NaN")
+}
+
+// overflowError describes a calculation that resulted in infinity
+func overflowError() (*pb.AddResponse_ErrorInfo, error) {
+	return &pb.AddResponse_ErrorInfo{
+		Code:     "OVERFLOW",
+		Message:  "Calculation resulted in infinity",
+		Severity: pb.AddResponse_ErrorInfo_SEVERITY_CRITICAL,
+	}, fmt.Errorf("calculation overflow")
+}
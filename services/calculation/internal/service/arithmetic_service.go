@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+)
+
+// Subtract subtracts every following number from the first one
+func (s *AdditionService) Subtract(ctx context.Context, req *pb.SubtractRequest) (*pb.SubtractResponse, error) {
+	return idempotent(s, ctx, "subtract", req, s.subtract)
+}
+
+// subtract performs the subtraction of Subtract
+func (s *AdditionService) subtract(ctx context.Context, req *pb.SubtractRequest) (*pb.SubtractResponse, error) {
+	// Validate request ID
+	requestID := req.RequestId
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+
+	// Validate numbers against constraints
+	operands, errInfo, err := validateNumbers(req.Numbers, req.Constraints)
+	if err != nil {
+		return &pb.SubtractResponse{
+			RequestId: requestID,
+			Error:     errInfo,
+		}, err
+	}
+	numbers := operands.numbers
+
+	// Perform subtraction
+	result := numbers[0]
+	for _, num := range numbers[1:] {
+		result -= num
+	}
+
+	// Check for overflow and undefined results
+	if errInfo, err := checkResult(result, numbers); err != nil {
+		return &pb.SubtractResponse{
+			RequestId: requestID,
+			Error:     errInfo,
+		}, err
+	}
+
+	// Prepare response with calculation metadata
+	return &pb.SubtractResponse{
+		Result:    result,
+		RequestId: requestID,
+		CalculationMetadata: &pb.AddResponse_CalculationMetadata{
+			CalculationTime:   timestamppb.Now(),
+			NumbersProcessed:  int32(len(numbers)),
+			ClampedCount:      int32(operands.clamped),
+			DroppedCount:      int32(operands.dropped),
+			Warnings:          operands.warnings,
+			CalculationMethod: "simple_subtraction",
+		},
+	}, nil
+}
+
+// Multiply multiplies the numbers in the request
+func (s *AdditionService) Multiply(ctx context.Context, req *pb.MultiplyRequest) (*pb.MultiplyResponse, error) {
+	return idempotent(s, ctx, "multiply", req, s.multiply)
+}
+
+// multiply performs the multiplication of Multiply
+func (s *AdditionService) multiply(ctx context.Context, req *pb.MultiplyRequest) (*pb.MultiplyResponse, error) {
+	// Validate request ID
+	requestID := req.RequestId
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+
+	// Validate numbers against constraints
+	operands, errInfo, err := validateNumbers(req.Numbers, req.Constraints)
+	if err != nil {
+		return &pb.MultiplyResponse{
+			RequestId: requestID,
+			Error:     errInfo,
+		}, err
+	}
+	numbers := operands.numbers
+
+	// Perform multiplication
+	result := 1.0
+	for _, num := range numbers {
+		result *= num
+	}
+
+	// Check for overflow and undefined results
+	if errInfo, err := checkResult(result, numbers); err != nil {
+		return &pb.MultiplyResponse{
+			RequestId: requestID,
+			Error:     errInfo,
+		}, err
+	}
+
+	// Prepare response with calculation metadata
+	return &pb.MultiplyResponse{
+		Result:    result,
+		RequestId: requestID,
+		CalculationMetadata: &pb.AddResponse_CalculationMetadata{
+			CalculationTime:   timestamppb.Now(),
+			NumbersProcessed:  int32(len(numbers)),
+			ClampedCount:      int32(operands.clamped),
+			DroppedCount:      int32(operands.dropped),
+			Warnings:          operands.warnings,
+			CalculationMethod: "simple_multiplication",
+		},
+	}, nil
+}
+
+// Divide divides the first number by every following number
+func (s *AdditionService) Divide(ctx context.Context, req *pb.DivideRequest) (*pb.DivideResponse, error) {
+	return idempotent(s, ctx, "divide", req, s.divide)
+}
+
+// divide performs the division of Divide
+func (s *AdditionService) divide(ctx context.Context, req *pb.DivideRequest) (*pb.DivideResponse, error) {
+	// Validate request ID
+	requestID := req.RequestId
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+
+	// Validate numbers against constraints
+	operands, errInfo, err := validateNumbers(req.Numbers, req.Constraints)
+	if err != nil {
+		return &pb.DivideResponse{
+			RequestId: requestID,
+			Error:     errInfo,
+		}, err
+	}
+	numbers := operands.numbers
+
+	// Reject zero divisors before dividing
+	for i, num := range numbers[1:] {
+		if num == 0 {
+			return &pb.DivideResponse{
+				RequestId: requestID,
+				Error: &pb.AddResponse_ErrorInfo{
+					Code:     "DIVISION_BY_ZERO",
+					Message:  fmt.Sprintf("Divisor at position %d is zero", i+1),
+					Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+				},
+			}, fmt.Errorf("division by zero")
+		}
+	}
+
+	// Perform division
+	result := numbers[0]
+	for _, num := range numbers[1:] {
+		result /= num
+	}
+
+	// Check for overflow and undefined results
+	if errInfo, err := checkResult(result, numbers); err != nil {
+		return &pb.DivideResponse{
+			RequestId: requestID,
+			Error:     errInfo,
+		}, err
+	}
+
+	// Prepare response with calculation metadata
+	return &pb.DivideResponse{
+		Result:    result,
+		RequestId: requestID,
+		CalculationMetadata: &pb.AddResponse_CalculationMetadata{
+			CalculationTime:   timestamppb.Now(),
+			NumbersProcessed:  int32(len(numbers)),
+			ClampedCount:      int32(operands.clamped),
+			DroppedCount:      int32(operands.dropped),
+			Warnings:          operands.warnings,
+			CalculationMethod: "simple_division",
+		},
+	}, nil
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	pb "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+)
+
+// BatchAdd performs every addition in the request. A failing item carries its
+// own error and does not fail the batch.
+func (s *AdditionService) BatchAdd(ctx context.Context, req *pb.BatchAddRequest) (*pb.BatchAddResponse, error) {
+	// Validate request ID
+	requestID := req.RequestId
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+
+	// Validate request
+	if len(req.Requests) == 0 {
+		return &pb.BatchAddResponse{
+			RequestId: requestID,
+			Error: &pb.AddResponse_ErrorInfo{
+				Code:     "NO_REQUESTS",
+				Message:  "No requests provided in batch",
+				Severity: pb.AddResponse_ErrorInfo_SEVERITY_WARNING,
+			},
+		}, fmt.Errorf("no requests provided")
+	}
+
+	// Perform additions in order
+	response := &pb.BatchAddResponse{
+		RequestId: requestID,
+		Responses: make([]*pb.AddResponse, 0, len(req.Requests)),
+	}
+	for _, item := range req.Requests {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		itemResponse, err := s.Add(ctx, item)
+		if err != nil {
+			response.FailedCount++
+		}
+		response.Responses = append(response.Responses, itemResponse)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+)
+
+// addComplex sums complex operands by adding their real and imaginary parts
+// with the requested summation algorithm
+func (s *AdditionService) addComplex(ctx context.Context, requestID string, req *pb.AddRequest) (*pb.AddResponse, error) {
+	// Check max number of numbers
+	if req.Constraints != nil {
+		if errInfo, err := checkMaxNumbers(len(req.ComplexNumbers), req.Constraints); err != nil {
+			return &pb.AddResponse{RequestId: requestID, Error: errInfo}, err
+		}
+	}
+
+	// Split operands into real and imaginary parts, applying the NaN and
+	// infinity policies and the min and max values to both parts. An operand
+	// is skipped if either part is.
+	reals := make([]float64, 0, len(req.ComplexNumbers))
+	imags := make([]float64, 0, len(req.ComplexNumbers))
+	for i, num := range req.ComplexNumbers {
+		keep := true
+		for _, part := range []float64{num.GetRe(), num.GetIm()} {
+			keepPart, errInfo, err := checkNonFinite(part, i, req.Constraints)
+			if err != nil {
+				return &pb.AddResponse{RequestId: requestID, Error: errInfo}, err
+			}
+			if !keepPart {
+				keep = false
+				continue
+			}
+			if req.Constraints != nil {
+				if errInfo, err := checkRange(part, req.Constraints); err != nil {
+					return &pb.AddResponse{RequestId: requestID, Error: errInfo}, err
+				}
+			}
+		}
+		if keep {
+			reals = append(reals, num.GetRe())
+			imags = append(imags, num.GetIm())
+		}
+	}
+	if len(reals) == 0 {
+		errInfo, err := noNumbersError()
+		return &pb.AddResponse{RequestId: requestID, Error: errInfo}, err
+	}
+
+	// Select summation algorithm
+	algorithm, ok := selectAlgorithm(req.Algorithm, len(reals))
+	if !ok {
+		return &pb.AddResponse{
+			RequestId: requestID,
+			Error: &pb.AddResponse_ErrorInfo{
+				Code:     "INVALID_ALGORITHM",
+				Message:  fmt.Sprintf("Unsupported summation algorithm %s", req.Algorithm),
+				Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+			},
+		}, fmt.Errorf("invalid summation algorithm")
+	}
+
+	// Perform addition, stopping early if the call is cancelled
+	var im float64
+	re, err := algorithm.sum(ctx, reals)
+	if err == nil {
+		im, err = algorithm.sum(ctx, imags)
+	}
+	if err != nil {
+		errInfo, err := cancelledError(err)
+		return &pb.AddResponse{RequestId: requestID, Error: errInfo}, err
+	}
+
+	// Check for overflow and undefined parts such as inf + -inf
+	for _, part := range []struct {
+		sum      float64
+		operands []float64
+	}{{re, reals}, {im, imags}} {
+		if errInfo, err := checkResult(part.sum, part.operands); err != nil {
+			return &pb.AddResponse{RequestId: requestID, Error: errInfo}, err
+		}
+	}
+
+	// Prepare response with calculation metadata
+	return &pb.AddResponse{
+		ComplexResult: &pb.Complex{Re: re, Im: im},
+		RequestId:     requestID,
+		CalculationMetadata: &pb.AddResponse_CalculationMetadata{
+			CalculationTime:   timestamppb.Now(),
+			NumbersProcessed:  int32(len(reals)),
+			CalculationMethod: algorithm.method,
+		},
+	}, nil
+}
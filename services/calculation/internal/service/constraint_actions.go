@@ -0,0 +1,159 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+
+	pb "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+)
+
+// validated holds the operands left after validation together with the
+// adjustments made by the constraint actions
+type validated struct {
+	// Operands to calculate with
+	numbers []float64
+	// Request position of every operand
+	positions []int
+	// Number of operands clamped to a constraint bound
+	clamped int
+	// Number of operands left out by a drop action
+	dropped int
+	// Violated constraints whose action is to warn
+	warnings []*pb.AddResponse_ErrorInfo
+}
+
+// constraintAction returns the action configured for the constraint whose
+// check failed with code
+func constraintAction(code string, constraints *pb.AddRequest_Constraints) pb.ConstraintAction {
+	switch code {
+	case "VALUE_TOO_LOW":
+		return constraints.GetMinValueAction()
+	case "VALUE_TOO_HIGH":
+		return constraints.GetMaxValueAction()
+	case "CONSTRAINT_VIOLATION":
+		return constraints.GetMaxNumbersAction()
+	case "NOT_INTEGER":
+		return constraints.GetIntegerOnlyAction()
+	case "TOO_MANY_DECIMALS":
+		return constraints.GetMaxDecimalPlacesAction()
+	case "NEGATIVE_VALUE":
+		return constraints.GetNonNegativeAction()
+	case "DUPLICATE_VALUE":
+		return constraints.GetUniqueAction()
+	case "SUM_TOO_LOW":
+		return constraints.GetMinSumAction()
+	case "SUM_TOO_HIGH":
+		return constraints.GetMaxSumAction()
+	case "TOO_FEW_NUMBERS":
+		return constraints.GetMinNumbersAction()
+	case "NOT_FINITE":
+		return constraints.GetFiniteOnlyAction()
+	}
+	return pb.ConstraintAction_CONSTRAINT_ACTION_REJECT
+}
+
+// checkActions rejects unknown actions and actions a constraint does not
+// support. Per-number constraints are rejected, clamped or dropped, while
+// constraints on all numbers are rejected or reported as warnings.
+func checkActions(constraints *pb.AddRequest_Constraints) (*pb.AddResponse_ErrorInfo, error) {
+	const (
+		reject = pb.ConstraintAction_CONSTRAINT_ACTION_REJECT
+		clamp  = pb.ConstraintAction_CONSTRAINT_ACTION_CLAMP
+		drop   = pb.ConstraintAction_CONSTRAINT_ACTION_DROP
+		warn   = pb.ConstraintAction_CONSTRAINT_ACTION_WARN
+	)
+	perNumber := []pb.ConstraintAction{reject, clamp, drop}
+	dropOnly := []pb.ConstraintAction{reject, drop}
+	aggregate := []pb.ConstraintAction{reject, warn}
+	actions := []struct {
+		rule      string
+		action    pb.ConstraintAction
+		supported []pb.ConstraintAction
+	}{
+		{"min_value", constraints.GetMinValueAction(), perNumber},
+		{"max_value", constraints.GetMaxValueAction(), perNumber},
+		{"max_numbers", constraints.GetMaxNumbersAction(), dropOnly},
+		{"integer_only", constraints.GetIntegerOnlyAction(), perNumber},
+		{"max_decimal_places", constraints.GetMaxDecimalPlacesAction(), perNumber},
+		{"non_negative", constraints.GetNonNegativeAction(), perNumber},
+		{"unique", constraints.GetUniqueAction(), dropOnly},
+		{"finite_only", constraints.GetFiniteOnlyAction(), dropOnly},
+		{"min_sum", constraints.GetMinSumAction(), aggregate},
+		{"max_sum", constraints.GetMaxSumAction(), aggregate},
+		{"min_numbers", constraints.GetMinNumbersAction(), aggregate},
+	}
+	for _, a := range actions {
+		if a.action == pb.ConstraintAction_CONSTRAINT_ACTION_UNSPECIFIED || slices.Contains(a.supported, a.action) {
+			continue
+		}
+		return &pb.AddResponse_ErrorInfo{
+			Code:     "INVALID_CONSTRAINT_ACTION",
+			Message:  fmt.Sprintf("Unsupported action %s for constraint %s", a.action, a.rule),
+			Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+		}, fmt.Errorf("invalid constraint action")
+	}
+	return nil, nil
+}
+
+// warnOnly reports whether the violation described by errInfo is only to be
+// warned about, returning the warning to add to the response metadata
+func warnOnly(errInfo *pb.AddResponse_ErrorInfo, constraints *pb.AddRequest_Constraints) (*pb.AddResponse_ErrorInfo, bool) {
+	if constraintAction(errInfo.Code, constraints) != pb.ConstraintAction_CONSTRAINT_ACTION_WARN {
+		return nil, false
+	}
+	return &pb.AddResponse_ErrorInfo{
+		Code:     errInfo.Code,
+		Message:  errInfo.Message,
+		Severity: pb.AddResponse_ErrorInfo_SEVERITY_WARNING,
+	}, true
+}
+
+// clampOperand returns the value nearest to num allowed by the constraint
+// whose check failed with code
+func clampOperand(num float64, code string, constraints *pb.AddRequest_Constraints) float64 {
+	switch code {
+	case "VALUE_TOO_LOW":
+		return constraints.GetMinValue()
+	case "VALUE_TOO_HIGH":
+		return constraints.GetMaxValue()
+	case "NOT_INTEGER":
+		return math.Round(num)
+	case "TOO_MANY_DECIMALS":
+		rounded, _ := strconv.ParseFloat(strconv.FormatFloat(num, 'f', int(constraints.GetMaxDecimalPlaces()), 64), 64)
+		return rounded
+	case "NEGATIVE_VALUE":
+		return 0
+	}
+	return num
+}
+
+// maxClamps bounds how often one operand is clamped, so that conflicting
+// constraints such as min_value above max_value cannot clamp forever
+const maxClamps = 4
+
+// resolveOperand checks num against the per-number constraints and applies
+// the action of every violated one. It returns the value to calculate with and
+// the last action applied, or the error of a rejected violation.
+func resolveOperand(num float64, constraints *pb.AddRequest_Constraints) (float64, pb.ConstraintAction, *pb.AddResponse_ErrorInfo, error) {
+	applied := pb.ConstraintAction_CONSTRAINT_ACTION_UNSPECIFIED
+	for clamps := 0; ; clamps++ {
+		errInfo, err := checkOperand(num, constraints)
+		if err == nil {
+			return num, applied, nil, nil
+		}
+
+		switch constraintAction(errInfo.Code, constraints) {
+		case pb.ConstraintAction_CONSTRAINT_ACTION_DROP:
+			return num, pb.ConstraintAction_CONSTRAINT_ACTION_DROP, nil, nil
+		case pb.ConstraintAction_CONSTRAINT_ACTION_CLAMP:
+			if clamps < maxClamps {
+				num = clampOperand(num, errInfo.Code, constraints)
+				applied = pb.ConstraintAction_CONSTRAINT_ACTION_CLAMP
+				continue
+			}
+		}
+		return num, pb.ConstraintAction_CONSTRAINT_ACTION_REJECT, errInfo, err
+	}
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/internal/decimal"
+)
+
+// roundingModes maps the proto rounding modes to their decimal counterparts
+var roundingModes = map[pb.RoundingMode]decimal.RoundingMode{
+	pb.RoundingMode_ROUNDING_MODE_UNSPECIFIED: decimal.HalfEven,
+	pb.RoundingMode_ROUNDING_MODE_HALF_EVEN:   decimal.HalfEven,
+	pb.RoundingMode_ROUNDING_MODE_HALF_UP:     decimal.HalfUp,
+	pb.RoundingMode_ROUNDING_MODE_HALF_DOWN:   decimal.HalfDown,
+	pb.RoundingMode_ROUNDING_MODE_DOWN:        decimal.Down,
+	pb.RoundingMode_ROUNDING_MODE_UP:          decimal.Up,
+	pb.RoundingMode_ROUNDING_MODE_CEILING:     decimal.Ceiling,
+	pb.RoundingMode_ROUNDING_MODE_FLOOR:       decimal.Floor,
+}
+
+// addDecimal sums the decimal operands of the request exactly
+func (s *AdditionService) addDecimal(requestID string, req *pb.AddRequest) (*pb.AddResponse, error) {
+	errorResponse := func(code, message string, severity pb.AddResponse_ErrorInfo_Severity, err error) (*pb.AddResponse, error) {
+		return &pb.AddResponse{
+			RequestId: requestID,
+			Error: &pb.AddResponse_ErrorInfo{
+				Code:     code,
+				Message:  message,
+				Severity: severity,
+			},
+		}, err
+	}
+
+	// Validate decimal options
+	options := req.DecimalOptions
+	mode, ok := roundingModes[options.GetRoundingMode()]
+	if !ok {
+		return errorResponse("INVALID_ROUNDING_MODE", fmt.Sprintf("Unsupported rounding mode %s", options.GetRoundingMode()),
+			pb.AddResponse_ErrorInfo_SEVERITY_ERROR, fmt.Errorf("invalid rounding mode"))
+	}
+	fixedScale := options != nil && options.Scale != nil
+	if fixedScale && (options.GetScale() < 0 || options.GetScale() > decimal.MaxScale) {
+		return errorResponse("INVALID_SCALE", fmt.Sprintf("Scale must be between 0 and %d", decimal.MaxScale),
+			pb.AddResponse_ErrorInfo_SEVERITY_ERROR, fmt.Errorf("invalid scale"))
+	}
+
+	// Parse operands
+	numbers := make([]*big.Rat, len(req.DecimalNumbers))
+	for i, text := range req.DecimalNumbers {
+		num, err := decimal.Parse(text)
+		if err != nil {
+			return errorResponse("INVALID_DECIMAL", fmt.Sprintf("Decimal number at position %d is invalid: %v", i, err),
+				pb.AddResponse_ErrorInfo_SEVERITY_ERROR, err)
+		}
+		numbers[i] = num
+	}
+
+	// Validate operands against constraints, then add them exactly
+	operands, errInfo, err := validateExact(numbers, nil, false, req.Constraints)
+	if err != nil {
+		return &pb.AddResponse{RequestId: requestID, Error: errInfo}, err
+	}
+	sum := new(big.Rat)
+	for _, num := range operands.numbers {
+		sum.Add(sum, num)
+	}
+
+	// Round to the requested scale, or keep the exact scale of the sum
+	scale, _ := decimal.Scale(sum)
+	appliedMode := pb.RoundingMode_ROUNDING_MODE_UNSPECIFIED
+	if fixedScale {
+		scale = int(options.GetScale())
+		sum = decimal.Round(sum, scale, mode)
+		appliedMode = appliedRoundingMode(options.GetRoundingMode())
+	}
+	approximation, _ := sum.Float64()
+
+	// Validate the sum against constraints
+	warnings, errInfo, err := exactSumWarnings(sum, operands.warnings, req.Constraints)
+	if err != nil {
+		return &pb.AddResponse{RequestId: requestID, Error: errInfo}, err
+	}
+
+	// Prepare response with calculation metadata
+	return &pb.AddResponse{
+		Result:        approximation,
+		DecimalResult: decimal.Format(sum, scale),
+		RequestId:     requestID,
+		CalculationMetadata: &pb.AddResponse_CalculationMetadata{
+			CalculationTime:   timestamppb.Now(),
+			NumbersProcessed:  int32(len(operands.numbers)),
+			CalculationMethod: "decimal",
+			RoundingMode:      appliedMode,
+			ClampedCount:      int32(operands.clamped),
+			DroppedCount:      int32(operands.dropped),
+			Warnings:          warnings,
+		},
+	}, nil
+}
+
+// appliedRoundingMode resolves the rounding mode actually used for a
+// requested one, which defaults to half-even
+func appliedRoundingMode(mode pb.RoundingMode) pb.RoundingMode {
+	if mode == pb.RoundingMode_ROUNDING_MODE_UNSPECIFIED {
+		return pb.RoundingMode_ROUNDING_MODE_HALF_EVEN
+	}
+	return mode
+}
+
+// compareBound compares an exact operand with a bound that is either finite
+// or infinite, which big.Rat cannot represent
+func compareBound(num *big.Rat, bound float64) int {
+	switch {
+	case math.IsInf(bound, 1):
+		return -1
+	case math.IsInf(bound, -1):
+		return 1
+	default:
+		return num.Cmp(new(big.Rat).SetFloat64(bound))
+	}
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/internal/statistics"
+)
+
+// defaultPercentiles are reported when the request does not ask for any
+var defaultPercentiles = []float64{25, 75}
+
+// Describe computes descriptive statistics of the numbers in the request. A
+// request with a request ID is answered once, like the arithmetic operations.
+func (s *AdditionService) Describe(ctx context.Context, req *pb.DescribeRequest) (*pb.DescribeResponse, error) {
+	return idempotent(s, ctx, "describe", req, s.describe)
+}
+
+// describe computes the statistics of Describe
+func (s *AdditionService) describe(ctx context.Context, req *pb.DescribeRequest) (*pb.DescribeResponse, error) {
+	// Validate request ID
+	requestID := req.RequestId
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+
+	// Validate numbers against constraints
+	operands, errInfo, err := validateNumbers(req.Numbers, req.Constraints)
+	if err != nil {
+		return &pb.DescribeResponse{
+			RequestId: requestID,
+			Error:     errInfo,
+		}, err
+	}
+	numbers := operands.numbers
+
+	// Validate requested percentiles
+	percentiles := req.Percentiles
+	if len(percentiles) == 0 {
+		percentiles = defaultPercentiles
+	}
+	for _, p := range percentiles {
+		if math.IsNaN(p) || p < 0 || p > 100 {
+			return &pb.DescribeResponse{
+				RequestId: requestID,
+				Error: &pb.AddResponse_ErrorInfo{
+					Code:     "INVALID_PERCENTILE",
+					Message:  fmt.Sprintf("Percentile %f is outside the range 0 to 100", p),
+					Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+				},
+			}, fmt.Errorf("invalid percentile")
+		}
+	}
+
+	// Compute statistics
+	summary := statistics.Describe(numbers)
+
+	// Check for overflow and undefined results of finite operands
+	for _, value := range []float64{summary.Sum, summary.Mean, summary.Variance} {
+		if errInfo, err := checkResult(value, numbers); err != nil {
+			return &pb.DescribeResponse{
+				RequestId: requestID,
+				Error:     errInfo,
+			}, err
+		}
+	}
+
+	response := &pb.DescribeResponse{
+		RequestId:               requestID,
+		Sum:                     summary.Sum,
+		Mean:                    summary.Mean,
+		Variance:                summary.Variance,
+		StandardDeviation:       summary.StandardDeviation(),
+		SampleVariance:          summary.SampleVariance,
+		SampleStandardDeviation: summary.SampleStandardDeviation(),
+		Min:                     summary.Min,
+		Max:                     summary.Max,
+		Median:                  summary.Median(),
+		CalculationMetadata: &pb.AddResponse_CalculationMetadata{
+			CalculationTime:   timestamppb.Now(),
+			NumbersProcessed:  int32(summary.Count),
+			ClampedCount:      int32(operands.clamped),
+			DroppedCount:      int32(operands.dropped),
+			Warnings:          operands.warnings,
+			CalculationMethod: "descriptive_statistics",
+		},
+	}
+	for _, p := range percentiles {
+		response.Percentiles = append(response.Percentiles, &pb.DescribeResponse_Percentile{
+			Percentile: p,
+			Value:      summary.Percentile(p),
+		})
+	}
+
+	return response, nil
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+)
+
+// AddVectors adds vectors of equal length element-wise
+func (s *AdditionService) AddVectors(ctx context.Context, req *pb.AddVectorsRequest) (*pb.AddVectorsResponse, error) {
+	return recorded(s, ctx, "add_vectors", req, s.addVectors)
+}
+
+// addVectors performs the addition of AddVectors
+func (s *AdditionService) addVectors(ctx context.Context, req *pb.AddVectorsRequest) (*pb.AddVectorsResponse, error) {
+	// Validate request ID
+	requestID := req.RequestId
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+
+	// Validate shapes
+	operands := make([][]float64, len(req.Vectors))
+	for i, vector := range req.Vectors {
+		if len(vector.Values) != len(req.Vectors[0].Values) {
+			return &pb.AddVectorsResponse{
+				RequestId: requestID,
+				Error: &pb.AddResponse_ErrorInfo{
+					Code:     "SHAPE_MISMATCH",
+					Message:  fmt.Sprintf("Vector %d has length %d, expected %d", i, len(vector.Values), len(req.Vectors[0].Values)),
+					Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+				},
+			}, fmt.Errorf("shape mismatch")
+		}
+		operands[i] = vector.Values
+	}
+
+	// Perform element-wise addition
+	result, errInfo, err := addElementwise(operands, req.Constraints)
+	if err != nil {
+		return &pb.AddVectorsResponse{
+			RequestId: requestID,
+			Error:     errInfo,
+		}, err
+	}
+
+	// Prepare response with calculation metadata
+	return &pb.AddVectorsResponse{
+		Result:    &pb.Vector{Values: result},
+		RequestId: requestID,
+		CalculationMetadata: &pb.AddResponse_CalculationMetadata{
+			CalculationTime:   timestamppb.Now(),
+			NumbersProcessed:  int32(len(operands)),
+			CalculationMethod: "elementwise_addition",
+		},
+	}, nil
+}
+
+// AddMatrices adds matrices of equal shape element-wise
+func (s *AdditionService) AddMatrices(ctx context.Context, req *pb.AddMatricesRequest) (*pb.AddMatricesResponse, error) {
+	return recorded(s, ctx, "add_matrices", req, s.addMatrices)
+}
+
+// addMatrices performs the addition of AddMatrices
+func (s *AdditionService) addMatrices(ctx context.Context, req *pb.AddMatricesRequest) (*pb.AddMatricesResponse, error) {
+	// Validate request ID
+	requestID := req.RequestId
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+
+	// shapeError reports a malformed or mismatching matrix
+	shapeError := func(code, message string, err error) (*pb.AddMatricesResponse, error) {
+		return &pb.AddMatricesResponse{
+			RequestId: requestID,
+			Error: &pb.AddResponse_ErrorInfo{
+				Code:     code,
+				Message:  message,
+				Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+			},
+		}, err
+	}
+
+	// Validate shapes
+	operands := make([][]float64, len(req.Matrices))
+	for i, matrix := range req.Matrices {
+		if matrix.Rows < 0 || matrix.Columns < 0 || len(matrix.Values) != int(matrix.Rows)*int(matrix.Columns) {
+			return shapeError("INVALID_SHAPE", fmt.Sprintf("Matrix %d has %d values, expected %d rows x %d columns",
+				i, len(matrix.Values), matrix.Rows, matrix.Columns), fmt.Errorf("invalid shape"))
+		}
+		first := req.Matrices[0]
+		if matrix.Rows != first.Rows || matrix.Columns != first.Columns {
+			return shapeError("SHAPE_MISMATCH", fmt.Sprintf("Matrix %d has shape %dx%d, expected %dx%d",
+				i, matrix.Rows, matrix.Columns, first.Rows, first.Columns), fmt.Errorf("shape mismatch"))
+		}
+		operands[i] = matrix.Values
+	}
+
+	// Perform element-wise addition
+	result, errInfo, err := addElementwise(operands, req.Constraints)
+	if err != nil {
+		return &pb.AddMatricesResponse{
+			RequestId: requestID,
+			Error:     errInfo,
+		}, err
+	}
+
+	// Prepare response with calculation metadata
+	return &pb.AddMatricesResponse{
+		Result: &pb.Matrix{
+			Rows:    req.Matrices[0].Rows,
+			Columns: req.Matrices[0].Columns,
+			Values:  result,
+		},
+		RequestId: requestID,
+		CalculationMetadata: &pb.AddResponse_CalculationMetadata{
+			CalculationTime:   timestamppb.Now(),
+			NumbersProcessed:  int32(len(operands)),
+			CalculationMethod: "elementwise_addition",
+		},
+	}, nil
+}
+
+// addElementwise sums operands of equal length element by element. The
+// max_numbers constraint limits the number of operands; min_value, max_value
+// and the NaN and infinity policies apply to every element, and skipped
+// elements do not contribute to their sum.
+func addElementwise(operands [][]float64, constraints *pb.AddRequest_Constraints) ([]float64, *pb.AddResponse_ErrorInfo, error) {
+	// Validate request
+	if len(operands) == 0 {
+		errInfo, err := noNumbersError()
+		return nil, errInfo, err
+	}
+
+	// Validate constraints if provided
+	if constraints != nil {
+		if errInfo, err := checkMaxNumbers(len(operands), constraints); err != nil {
+			return nil, errInfo, err
+		}
+	}
+
+	// Perform addition, validating every element. Positions count the
+	// elements of all operands in order.
+	result := make([]float64, len(operands[0]))
+	propagated := make([]bool, len(result))
+	for j, operand := range operands {
+		for i, num := range operand {
+			keep, errInfo, err := checkNonFinite(num, j*len(result)+i, constraints)
+			if err != nil {
+				return nil, errInfo, err
+			}
+			if !keep {
+				continue
+			}
+			if constraints != nil {
+				if errInfo, err := checkRange(num, constraints); err != nil {
+					return nil, errInfo, err
+				}
+			}
+			result[i] += num
+			propagated[i] = propagated[i] || math.IsNaN(num) || math.IsInf(num, 0)
+		}
+	}
+
+	// Check for overflow and undefined results of finite elements
+	for i, num := range result {
+		if propagated[i] {
+			continue
+		}
+		if errInfo, err := checkResult(num, nil); err != nil {
+			return nil, errInfo, err
+		}
+	}
+
+	return result, nil, nil
+}
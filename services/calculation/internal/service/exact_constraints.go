@@ -0,0 +1,313 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	pb "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/internal/decimal"
+)
+
+// exactValidated holds the exact operands left after validation together with
+// the adjustments made by the constraint actions
+type exactValidated struct {
+	// Operands to calculate with
+	numbers []*big.Rat
+	// Request position of every operand
+	positions []int
+	// Number of operands clamped to a constraint bound
+	clamped int
+	// Number of operands left out by a drop action
+	dropped int
+	// Violated constraints whose action is to warn
+	warnings []*pb.AddResponse_ErrorInfo
+}
+
+// validateExact checks the operands of the decimal, integer, fraction and
+// money modes against the optional constraints, as validateNumbers does for
+// doubles. Exact operands are always finite, so the NaN and infinity policies
+// do not apply. keys, if set, tell apart equal operands for the unique
+// constraint, such as amounts in different currencies. Clamping an integral
+// operand keeps it integral.
+func validateExact(numbers []*big.Rat, keys []string, integral bool, constraints *pb.AddRequest_Constraints) (exactValidated, *pb.AddResponse_ErrorInfo, error) {
+	// Validate request
+	if len(numbers) == 0 {
+		errInfo, err := noNumbersError()
+		return exactValidated{}, errInfo, err
+	}
+
+	// Validate constraints
+	if errInfo, err := checkExactBounds(constraints); err != nil {
+		return exactValidated{}, errInfo, err
+	}
+	if errInfo, err := checkActions(constraints); err != nil {
+		return exactValidated{}, errInfo, err
+	}
+
+	collected := &violations{collectAll: constraints.GetValidationMode() == pb.ValidationMode_VALIDATION_MODE_COLLECT_ALL}
+	dropExtra := constraints.GetMaxNumbersAction() == pb.ConstraintAction_CONSTRAINT_ACTION_DROP
+	var warnings []*pb.AddResponse_ErrorInfo
+
+	// Check min and max number of numbers
+	if constraints != nil {
+		if errInfo, err := checkMinNumbers(len(numbers), constraints); err != nil {
+			if warning, ok := warnOnly(errInfo, constraints); ok {
+				warnings = append(warnings, warning)
+			} else if !collected.add(-1, 0, errInfo) {
+				return exactValidated{}, errInfo, err
+			}
+		}
+		if errInfo, err := checkMaxNumbers(len(numbers), constraints); err != nil && !dropExtra {
+			first := max(int(constraints.GetMaxNumbers()), 0)
+			value, _ := numbers[first].Float64()
+			if !collected.add(first, value, errInfo) {
+				return exactValidated{}, errInfo, err
+			}
+		}
+	}
+
+	kept := exactValidated{
+		numbers:   make([]*big.Rat, 0, len(numbers)),
+		positions: make([]int, 0, len(numbers)),
+		warnings:  warnings,
+	}
+	seen := make(map[string]int)
+	for i, num := range numbers {
+		// Validate the value of the number, clamping or dropping it if
+		// the violated constraint says so
+		if constraints != nil {
+			value, action, errInfo, err := resolveExactOperand(num, integral, constraints)
+			if err != nil {
+				approximation, _ := num.Float64()
+				if !collected.add(i, approximation, errInfo) {
+					return exactValidated{}, errInfo, err
+				}
+				continue
+			}
+			switch action {
+			case pb.ConstraintAction_CONSTRAINT_ACTION_DROP:
+				kept.dropped++
+				continue
+			case pb.ConstraintAction_CONSTRAINT_ACTION_CLAMP:
+				kept.clamped++
+			}
+			num = value
+		}
+
+		// Validate uniqueness
+		if constraints.GetUnique() {
+			key := num.RatString()
+			if keys != nil {
+				key += " " + keys[i]
+			}
+			if previous, ok := seen[key]; ok {
+				if constraints.GetUniqueAction() == pb.ConstraintAction_CONSTRAINT_ACTION_DROP {
+					kept.dropped++
+					continue
+				}
+				errInfo, err := duplicateValueError(0, i, previous)
+				errInfo.Message = fmt.Sprintf("Number %s at position %d duplicates position %d", exactString(num), i, previous)
+				approximation, _ := num.Float64()
+				if !collected.add(i, approximation, errInfo) {
+					return exactValidated{}, errInfo, err
+				}
+				continue
+			}
+			seen[key] = i
+		}
+
+		kept.numbers = append(kept.numbers, num)
+		kept.positions = append(kept.positions, i)
+	}
+
+	if errInfo, err := collected.result(); err != nil {
+		return exactValidated{}, errInfo, err
+	}
+
+	// Drop the operands beyond the maximum number of numbers
+	if limit := int(constraints.GetMaxNumbers()); dropExtra && constraints.MaxNumbers != nil && len(kept.numbers) > limit {
+		limit = max(limit, 0)
+		kept.dropped += len(kept.numbers) - limit
+		kept.numbers = kept.numbers[:limit]
+		kept.positions = kept.positions[:limit]
+	}
+
+	// Every operand may have been dropped
+	if len(kept.numbers) == 0 {
+		errInfo, err := noNumbersError()
+		return exactValidated{}, errInfo, err
+	}
+
+	return kept, nil, nil
+}
+
+// checkExactBounds rejects NaN value and sum bounds, which exact operands
+// cannot be compared with
+func checkExactBounds(constraints *pb.AddRequest_Constraints) (*pb.AddResponse_ErrorInfo, error) {
+	if constraints == nil {
+		return nil, nil
+	}
+	for _, bound := range []*float64{constraints.MinValue, constraints.MaxValue, constraints.MinSum, constraints.MaxSum} {
+		if bound != nil && math.IsNaN(*bound) {
+			return &pb.AddResponse_ErrorInfo{
+				Code:     "INVALID_CONSTRAINT",
+				Message:  "Min and max values and sums must be numbers",
+				Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+			}, fmt.Errorf("invalid constraint")
+		}
+	}
+	return nil, nil
+}
+
+// resolveExactOperand checks an exact operand against the per-number
+// constraints and applies the action of every violated one, as
+// resolveOperand does for doubles. An operand that cannot be clamped, such
+// as one below an infinite minimum, is rejected.
+func resolveExactOperand(num *big.Rat, integral bool, constraints *pb.AddRequest_Constraints) (*big.Rat, pb.ConstraintAction, *pb.AddResponse_ErrorInfo, error) {
+	applied := pb.ConstraintAction_CONSTRAINT_ACTION_UNSPECIFIED
+	for clamps := 0; ; clamps++ {
+		errInfo, err := checkExactOperand(num, constraints)
+		if err == nil {
+			return num, applied, nil, nil
+		}
+
+		switch constraintAction(errInfo.Code, constraints) {
+		case pb.ConstraintAction_CONSTRAINT_ACTION_DROP:
+			return num, pb.ConstraintAction_CONSTRAINT_ACTION_DROP, nil, nil
+		case pb.ConstraintAction_CONSTRAINT_ACTION_CLAMP:
+			if clamped, ok := clampExactOperand(num, errInfo.Code, integral, constraints); ok && clamps < maxClamps {
+				num = clamped
+				applied = pb.ConstraintAction_CONSTRAINT_ACTION_CLAMP
+				continue
+			}
+		}
+		return num, pb.ConstraintAction_CONSTRAINT_ACTION_REJECT, errInfo, err
+	}
+}
+
+// checkExactOperand rejects an exact operand violating any of the per-number
+// constraints
+func checkExactOperand(num *big.Rat, constraints *pb.AddRequest_Constraints) (*pb.AddResponse_ErrorInfo, error) {
+	if constraints.MinValue != nil && compareBound(num, *constraints.MinValue) < 0 {
+		return &pb.AddResponse_ErrorInfo{
+			Code:     "VALUE_TOO_LOW",
+			Message:  fmt.Sprintf("Number %s is below minimum %f", exactString(num), *constraints.MinValue),
+			Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+		}, fmt.Errorf("number below minimum")
+	}
+
+	if constraints.MaxValue != nil && compareBound(num, *constraints.MaxValue) > 0 {
+		return &pb.AddResponse_ErrorInfo{
+			Code:     "VALUE_TOO_HIGH",
+			Message:  fmt.Sprintf("Number %s is above maximum %f", exactString(num), *constraints.MaxValue),
+			Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+		}, fmt.Errorf("number above maximum")
+	}
+
+	if constraints.NonNegative && num.Sign() < 0 {
+		return &pb.AddResponse_ErrorInfo{
+			Code:     "NEGATIVE_VALUE",
+			Message:  fmt.Sprintf("Number %s is negative", exactString(num)),
+			Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+		}, fmt.Errorf("negative number")
+	}
+
+	if constraints.IntegerOnly && !num.IsInt() {
+		return &pb.AddResponse_ErrorInfo{
+			Code:     "NOT_INTEGER",
+			Message:  fmt.Sprintf("Number %s is not an integer", exactString(num)),
+			Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+		}, fmt.Errorf("number not an integer")
+	}
+
+	if constraints.MaxDecimalPlaces != nil {
+		if scale, ok := decimal.Scale(num); !ok || scale > int(*constraints.MaxDecimalPlaces) {
+			return &pb.AddResponse_ErrorInfo{
+				Code:     "TOO_MANY_DECIMALS",
+				Message:  fmt.Sprintf("Number %s has more than %d decimal places", exactString(num), *constraints.MaxDecimalPlaces),
+				Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+			}, fmt.Errorf("too many decimal places")
+		}
+	}
+
+	return nil, nil
+}
+
+// clampExactOperand returns the value nearest to num allowed by the
+// constraint whose check failed with code. It reports false if there is no
+// such exact value, as for an infinite bound.
+func clampExactOperand(num *big.Rat, code string, integral bool, constraints *pb.AddRequest_Constraints) (*big.Rat, bool) {
+	switch code {
+	case "VALUE_TOO_LOW":
+		return exactBound(constraints.GetMinValue(), integral, decimal.Ceiling)
+	case "VALUE_TOO_HIGH":
+		return exactBound(constraints.GetMaxValue(), integral, decimal.Floor)
+	case "NOT_INTEGER":
+		return decimal.Round(num, 0, decimal.HalfUp), true
+	case "TOO_MANY_DECIMALS":
+		return decimal.Round(num, max(int(constraints.GetMaxDecimalPlaces()), 0), decimal.HalfEven), true
+	case "NEGATIVE_VALUE":
+		return new(big.Rat), true
+	}
+	return num, false
+}
+
+// exactBound converts a finite bound into an exact operand, rounded with mode
+// to an integer inside the bound for integral operands
+func exactBound(bound float64, integral bool, mode decimal.RoundingMode) (*big.Rat, bool) {
+	if math.IsInf(bound, 0) {
+		return nil, false
+	}
+	value := new(big.Rat).SetFloat64(bound)
+	if integral {
+		value = decimal.Round(value, 0, mode)
+	}
+	return value, true
+}
+
+// checkExactSum rejects an exact sum outside the min and max sum
+func checkExactSum(sum *big.Rat, constraints *pb.AddRequest_Constraints) (*pb.AddResponse_ErrorInfo, error) {
+	if constraints.MinSum != nil && compareBound(sum, *constraints.MinSum) < 0 {
+		return &pb.AddResponse_ErrorInfo{
+			Code:     "SUM_TOO_LOW",
+			Message:  fmt.Sprintf("Sum %s is below minimum %f", exactString(sum), *constraints.MinSum),
+			Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+		}, fmt.Errorf("sum below minimum")
+	}
+
+	if constraints.MaxSum != nil && compareBound(sum, *constraints.MaxSum) > 0 {
+		return &pb.AddResponse_ErrorInfo{
+			Code:     "SUM_TOO_HIGH",
+			Message:  fmt.Sprintf("Sum %s is above maximum %f", exactString(sum), *constraints.MaxSum),
+			Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+		}, fmt.Errorf("sum above maximum")
+	}
+
+	return nil, nil
+}
+
+// exactSumWarnings checks an exact sum against the constraints, returning
+// the violations whose action is to warn or the error of a rejected one
+func exactSumWarnings(sum *big.Rat, warnings []*pb.AddResponse_ErrorInfo, constraints *pb.AddRequest_Constraints) ([]*pb.AddResponse_ErrorInfo, *pb.AddResponse_ErrorInfo, error) {
+	if constraints == nil {
+		return warnings, nil, nil
+	}
+	if errInfo, err := checkExactSum(sum, constraints); err != nil {
+		warning, ok := warnOnly(errInfo, constraints)
+		if !ok {
+			return nil, errInfo, err
+		}
+		warnings = append(warnings, warning)
+	}
+	return warnings, nil, nil
+}
+
+// exactString renders an exact operand in decimal notation if it has a finite
+// decimal representation, or as a fraction otherwise
+func exactString(num *big.Rat) string {
+	if scale, ok := decimal.Scale(num); ok {
+		return decimal.Format(num, scale)
+	}
+	return num.RatString()
+}
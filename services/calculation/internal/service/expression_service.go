@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/internal/expression"
+)
+
+// Evaluate parses the infix expression in the request and computes its value
+func (s *AdditionService) Evaluate(ctx context.Context, req *pb.EvaluateRequest) (*pb.EvaluateResponse, error) {
+	return recorded(s, ctx, "evaluate", req, s.evaluate)
+}
+
+// evaluate performs the evaluation of Evaluate
+func (s *AdditionService) evaluate(ctx context.Context, req *pb.EvaluateRequest) (*pb.EvaluateResponse, error) {
+	// Validate request ID
+	requestID := req.RequestId
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+
+	// Parse expression into a syntax tree
+	tree, err := expression.Parse(req.Expression)
+	if err != nil {
+		var parseErrors expression.ParseErrors
+		errors.As(err, &parseErrors)
+
+		errorInfos := make([]*pb.AddResponse_ErrorInfo, len(parseErrors))
+		for i, parseErr := range parseErrors {
+			position := int32(parseErr.Position)
+			errorInfos[i] = &pb.AddResponse_ErrorInfo{
+				Code:     "PARSE_ERROR",
+				Message:  parseErr.Message,
+				Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+				Position: &position,
+			}
+		}
+
+		return &pb.EvaluateResponse{
+			RequestId:   requestID,
+			Error:       errorInfos[0],
+			ParseErrors: errorInfos,
+		}, fmt.Errorf("invalid expression: %w", err)
+	}
+
+	// Evaluate syntax tree
+	result, err := tree.Eval()
+	if err != nil {
+		var evalErr *expression.EvalError
+		errors.As(err, &evalErr)
+		position := int32(evalErr.Position)
+
+		errInfo := &pb.AddResponse_ErrorInfo{
+			Code:     "OVERFLOW",
+			Message:  "Calculation resulted in infinity",
+			Severity: pb.AddResponse_ErrorInfo_SEVERITY_CRITICAL,
+			Position: &position,
+		}
+		if errors.Is(err, expression.ErrDivisionByZero) {
+			errInfo = &pb.AddResponse_ErrorInfo{
+				Code:     "DIVISION_BY_ZERO",
+				Message:  fmt.Sprintf("Division by zero at position %d", position),
+				Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+				Position: &position,
+			}
+		}
+
+		return &pb.EvaluateResponse{
+			RequestId: requestID,
+			Error:     errInfo,
+		}, err
+	}
+
+	// Prepare response with calculation metadata
+	return &pb.EvaluateResponse{
+		Result:    result,
+		RequestId: requestID,
+		CalculationMetadata: &pb.AddResponse_CalculationMetadata{
+			CalculationTime:   timestamppb.Now(),
+			NumbersProcessed:  int32(expression.CountNumbers(tree)),
+			CalculationMethod: "expression_evaluation",
+		},
+	}, nil
+}
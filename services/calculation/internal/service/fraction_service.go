@@ -0,0 +1,83 @@
+package service
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+)
+
+// addFractions sums the fraction operands of the request exactly
+func (s *AdditionService) addFractions(requestID string, req *pb.AddRequest) (*pb.AddResponse, error) {
+	errorResponse := func(message string, err error) (*pb.AddResponse, error) {
+		return &pb.AddResponse{
+			RequestId: requestID,
+			Error: &pb.AddResponse_ErrorInfo{
+				Code:     "INVALID_FRACTION",
+				Message:  message,
+				Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+			},
+		}, err
+	}
+
+	// Parse operands
+	numbers := make([]*big.Rat, len(req.FractionNumbers))
+	for i, fraction := range req.FractionNumbers {
+		numerator, ok := new(big.Int).SetString(strings.TrimSpace(fraction.GetNumerator()), 10)
+		if !ok {
+			return errorResponse(fmt.Sprintf("Numerator at position %d is invalid: %q", i, fraction.GetNumerator()),
+				fmt.Errorf("invalid numerator %q", fraction.GetNumerator()))
+		}
+
+		denominator := big.NewInt(1)
+		if text := strings.TrimSpace(fraction.GetDenominator()); text != "" {
+			if denominator, ok = denominator.SetString(text, 10); !ok {
+				return errorResponse(fmt.Sprintf("Denominator at position %d is invalid: %q", i, fraction.GetDenominator()),
+					fmt.Errorf("invalid denominator %q", fraction.GetDenominator()))
+			}
+		}
+		if denominator.Sign() == 0 {
+			return errorResponse(fmt.Sprintf("Denominator at position %d is zero", i), fmt.Errorf("zero denominator"))
+		}
+
+		numbers[i] = new(big.Rat).SetFrac(numerator, denominator)
+	}
+
+	// Validate operands against constraints, then add them exactly
+	operands, errInfo, err := validateExact(numbers, nil, false, req.Constraints)
+	if err != nil {
+		return &pb.AddResponse{RequestId: requestID, Error: errInfo}, err
+	}
+	sum := new(big.Rat)
+	for _, num := range operands.numbers {
+		sum.Add(sum, num)
+	}
+	approximation, _ := sum.Float64()
+
+	// Validate the sum against constraints
+	warnings, errInfo, err := exactSumWarnings(sum, operands.warnings, req.Constraints)
+	if err != nil {
+		return &pb.AddResponse{RequestId: requestID, Error: errInfo}, err
+	}
+
+	// Prepare response with calculation metadata
+	return &pb.AddResponse{
+		Result: approximation,
+		FractionResult: &pb.Fraction{
+			Numerator:   sum.Num().String(),
+			Denominator: sum.Denom().String(),
+		},
+		RequestId: requestID,
+		CalculationMetadata: &pb.AddResponse_CalculationMetadata{
+			CalculationTime:   timestamppb.Now(),
+			NumbersProcessed:  int32(len(operands.numbers)),
+			CalculationMethod: "rational",
+			ClampedCount:      int32(operands.clamped),
+			DroppedCount:      int32(operands.dropped),
+			Warnings:          warnings,
+		},
+	}, nil
+}
@@ -0,0 +1,460 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/internal/history"
+)
+
+const (
+	// historyMemory is the number of bytes of calculations kept by the
+	// default in-memory history
+	historyMemory = 64 << 20
+
+	// recordQueueSize is the number of calculations waiting to be saved in
+	// the history before further calculations wait for room in the queue
+	recordQueueSize = 4096
+
+	// recordTimeout is how long a calculation waits for room in a full
+	// queue before it goes unrecorded
+	recordTimeout = 100 * time.Millisecond
+
+	// maxRecordedValues caps the elements of every list kept in a recorded
+	// request or response
+	maxRecordedValues = 16
+
+	// maxRecordedString caps the bytes of every string kept in a recorded
+	// request or response
+	maxRecordedString = 1024
+
+	// defaultPageSize is the number of calculations listed per page unless
+	// the request asks for another page size
+	defaultPageSize = 50
+
+	// maxPageSize caps the number of calculations listed per page
+	maxPageSize = 1000
+)
+
+// calculationResponse is implemented by the responses of recorded
+// calculations
+type calculationResponse interface {
+	proto.Message
+	GetRequestId() string
+	GetError() *pb.AddResponse_ErrorInfo
+	GetCalculationMetadata() *pb.AddResponse_CalculationMetadata
+}
+
+// pendingRecord is a calculation waiting to be saved in the history. A
+// pending record with a flushed channel only marks a position in the queue.
+type pendingRecord struct {
+	record   history.Record
+	request  proto.Message
+	response proto.Message
+	flushed  chan struct{}
+}
+
+// recorder queues calculations to be saved in the history by a single
+// goroutine, so that encoding and writing them does not delay responses
+type recorder struct {
+	mu      sync.RWMutex
+	closed  bool
+	pending chan pendingRecord
+	// saved is closed once the queue is closed and drained
+	saved chan struct{}
+	// dropped counts the calculations that found the queue full
+	dropped atomic.Int64
+}
+
+// newRecorder creates a recorder queueing up to size calculations
+func newRecorder(size int) *recorder {
+	return &recorder{
+		pending: make(chan pendingRecord, size),
+		saved:   make(chan struct{}),
+	}
+}
+
+// enqueue queues a calculation, waiting up to recordTimeout while the queue
+// is full. Recording is best effort: the calculation is dropped when the
+// queue stays full or is closed. It returns false for a calculation dropped
+// from a full queue.
+func (r *recorder) enqueue(record pendingRecord) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.closed {
+		return true
+	}
+	select {
+	case r.pending <- record:
+		return true
+	default:
+	}
+
+	timer := time.NewTimer(recordTimeout)
+	defer timer.Stop()
+	select {
+	case r.pending <- record:
+		return true
+	case <-timer.C:
+		r.dropped.Add(1)
+		return false
+	}
+}
+
+// flush waits until every calculation queued so far is saved
+func (r *recorder) flush() {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.closed {
+		return
+	}
+	flushed := make(chan struct{})
+	r.pending <- pendingRecord{flushed: flushed}
+	<-flushed
+}
+
+// close stops accepting calculations and waits until the queued ones are
+// saved
+func (r *recorder) close() {
+	r.mu.Lock()
+	if !r.closed {
+		r.closed = true
+		close(r.pending)
+	}
+	r.mu.Unlock()
+	<-r.saved
+}
+
+// UseLogger sets the logger reporting calculations that could not be
+// recorded. It is meant to be called before the service starts serving
+// requests.
+func (s *AdditionService) UseLogger(logger zerolog.Logger) {
+	s.logger = logger
+}
+
+// UseHistory replaces the repository calculations are recorded in. It is
+// meant to be called before the service starts serving requests.
+func (s *AdditionService) UseHistory(repository history.Repository) {
+	s.history = repository
+}
+
+// OpenHistoryFile records calculations in the history file at path, keeping
+// the calculations already recorded in it
+func (s *AdditionService) OpenHistoryFile(path string) error {
+	repository, err := history.OpenFileRepository(path)
+	if err != nil {
+		return err
+	}
+	s.UseHistory(repository)
+	return nil
+}
+
+// Close cancels the submitted calculations that have not finished, saves the
+// calculations waiting to be recorded and releases the history repository
+func (s *AdditionService) Close() error {
+	s.jobs.Close()
+	s.recorder.close()
+	return s.history.Close()
+}
+
+// recorded performs a calculation and records it in the history
+func recorded[Req proto.Message, Resp calculationResponse](
+	s *AdditionService,
+	ctx context.Context,
+	operation string,
+	req Req,
+	calculate func(context.Context, Req) (Resp, error),
+) (Resp, error) {
+	start := time.Now()
+	response, err := calculate(ctx, req)
+	s.record(operation, req, response, start, time.Since(start))
+	return response, err
+}
+
+// record queues a calculation to be saved in the history. Only a summary of
+// the request and response is kept, so the cost of recording does not grow
+// with the number of operands.
+func (s *AdditionService) record(operation string, req proto.Message, response calculationResponse, start time.Time, duration time.Duration) {
+	if response.GetRequestId() == "" {
+		return
+	}
+
+	request, requestTruncated := summarize(req)
+	summary, responseTruncated := summarize(response)
+	queued := s.recorder.enqueue(pendingRecord{
+		record: history.Record{
+			RequestID:         response.GetRequestId(),
+			Operation:         operation,
+			ErrorCode:         response.GetError().GetCode(),
+			CalculationMethod: response.GetCalculationMetadata().GetCalculationMethod(),
+			NumbersProcessed:  response.GetCalculationMetadata().GetNumbersProcessed(),
+			Truncated:         requestTruncated || responseTruncated,
+			Time:              start,
+			Duration:          duration,
+		},
+		request:  request,
+		response: summary,
+	})
+	if !queued {
+		s.logger.Warn().
+			Str("request_id", response.GetRequestId()).
+			Str("operation", operation).
+			Int64("dropped", s.recorder.dropped.Load()).
+			Msg("History queue full, calculation not recorded")
+	}
+}
+
+// saveRecords encodes and saves the queued calculations until the recorder
+// is closed. The calculations queued while a batch is saved are saved
+// together in the next one. A failing repository does not stop recording.
+func (s *AdditionService) saveRecords() {
+	defer close(s.recorder.saved)
+
+	var (
+		batch   []history.Record
+		flushes []chan struct{}
+	)
+	for pending := range s.recorder.pending {
+		batch, flushes = s.appendRecord(batch[:0], flushes[:0], pending)
+
+		// Take the calculations already waiting without blocking
+	drain:
+		for len(batch) < recordQueueSize {
+			select {
+			case pending, ok := <-s.recorder.pending:
+				if !ok {
+					break drain
+				}
+				batch, flushes = s.appendRecord(batch, flushes, pending)
+			default:
+				break drain
+			}
+		}
+
+		if len(batch) > 0 {
+			if err := s.history.Save(context.Background(), batch...); err != nil {
+				s.logger.Error().
+					Err(err).
+					Int("records", len(batch)).
+					Msg("Failed to save calculation history")
+			}
+		}
+		for _, flushed := range flushes {
+			close(flushed)
+		}
+	}
+}
+
+// appendRecord encodes a queued calculation for saveRecords, adding it to
+// batch, or adds the channel of a flush marker to flushes. Calculations that
+// cannot be encoded are skipped.
+func (s *AdditionService) appendRecord(batch []history.Record, flushes []chan struct{}, pending pendingRecord) ([]history.Record, []chan struct{}) {
+	if pending.flushed != nil {
+		return batch, append(flushes, pending.flushed)
+	}
+
+	record := pending.record
+	var err error
+	if pending.request != nil {
+		if record.Request, err = protojson.Marshal(pending.request); err != nil {
+			return batch, flushes
+		}
+	}
+	if record.Response, err = protojson.Marshal(pending.response); err != nil {
+		return batch, flushes
+	}
+	return append(batch, record), flushes
+}
+
+// summarize copies a message, keeping at most maxRecordedValues elements of
+// every list and maxRecordedString bytes of every string, and reports whether
+// anything was cut short. The copy shares nothing with the message, so that
+// it can be encoded after the message has been returned.
+func summarize(message proto.Message) (proto.Message, bool) {
+	if message == nil || !message.ProtoReflect().IsValid() {
+		return nil, false
+	}
+	summary, truncated := summarizeMessage(message.ProtoReflect())
+	return summary.Interface(), truncated
+}
+
+// summarizeMessage copies the fields of a message for summarize
+func summarizeMessage(message protoreflect.Message) (protoreflect.Message, bool) {
+	summary := message.New()
+	truncated := false
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case field.IsList():
+			list := value.List()
+			values := summary.Mutable(field).List()
+			for i := 0; i < list.Len() && i < maxRecordedValues; i++ {
+				element, cut := summarizeValue(field, list.Get(i))
+				values.Append(element)
+				truncated = truncated || cut
+			}
+			truncated = truncated || list.Len() > maxRecordedValues
+		case field.IsMap():
+			entries := summary.Mutable(field).Map()
+			value.Map().Range(func(key protoreflect.MapKey, entry protoreflect.Value) bool {
+				if entries.Len() == maxRecordedValues {
+					truncated = true
+					return false
+				}
+				element, cut := summarizeValue(field.MapValue(), entry)
+				entries.Set(key, element)
+				truncated = truncated || cut
+				return true
+			})
+		default:
+			element, cut := summarizeValue(field, value)
+			summary.Set(field, element)
+			truncated = truncated || cut
+		}
+		return true
+	})
+	return summary, truncated
+}
+
+// summarizeValue copies a single value of a field for summarize
+func summarizeValue(field protoreflect.FieldDescriptor, value protoreflect.Value) (protoreflect.Value, bool) {
+	switch field.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		message, truncated := summarizeMessage(value.Message())
+		return protoreflect.ValueOfMessage(message), truncated
+	case protoreflect.StringKind:
+		text := value.String()
+		if len(text) <= maxRecordedString {
+			return value, false
+		}
+		return protoreflect.ValueOfString(strings.ToValidUTF8(text[:maxRecordedString], "")), true
+	case protoreflect.BytesKind:
+		data := value.Bytes()
+		if len(data) > maxRecordedString {
+			return protoreflect.ValueOfBytes(bytes.Clone(data[:maxRecordedString])), true
+		}
+		return protoreflect.ValueOfBytes(bytes.Clone(data)), false
+	default:
+		return value, false
+	}
+}
+
+// ListCalculations pages through the recorded calculations, newest first
+func (s *AdditionService) ListCalculations(ctx context.Context, req *pb.ListCalculationsRequest) (*pb.ListCalculationsResponse, error) {
+	// Validate page size
+	size := int(req.PageSize)
+	switch {
+	case size < 0:
+		return &pb.ListCalculationsResponse{
+			Error: &pb.AddResponse_ErrorInfo{
+				Code:     "INVALID_PAGE_SIZE",
+				Message:  fmt.Sprintf("Page size %d is negative", size),
+				Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+			},
+		}, fmt.Errorf("invalid page size")
+	case size == 0:
+		size = defaultPageSize
+	case size > maxPageSize:
+		size = maxPageSize
+	}
+
+	filter := history.Filter{
+		ErrorCode:         req.ErrorCode,
+		CalculationMethod: req.CalculationMethod,
+		RequestIDPrefix:   req.RequestIdPrefix,
+	}
+	if req.StartTime != nil {
+		filter.Start = req.StartTime.AsTime()
+	}
+	if req.EndTime != nil {
+		filter.End = req.EndTime.AsTime()
+	}
+
+	// Include the calculations still waiting to be saved
+	s.recorder.flush()
+
+	records, next, err := s.history.List(ctx, filter, req.PageToken, size)
+	if errors.Is(err, history.ErrInvalidPageToken) {
+		return &pb.ListCalculationsResponse{
+			Error: &pb.AddResponse_ErrorInfo{
+				Code:     "INVALID_PAGE_TOKEN",
+				Message:  fmt.Sprintf("Invalid page token %q", req.PageToken),
+				Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+			},
+		}, err
+	}
+	if err != nil {
+		return &pb.ListCalculationsResponse{Error: historyUnavailableError(err)}, err
+	}
+
+	calculations := make([]*pb.Calculation, len(records))
+	for i, record := range records {
+		calculations[i] = toCalculation(record)
+	}
+	return &pb.ListCalculationsResponse{
+		Calculations:  calculations,
+		NextPageToken: next,
+	}, nil
+}
+
+// GetCalculation looks up the latest calculation recorded for a request ID
+func (s *AdditionService) GetCalculation(ctx context.Context, req *pb.GetCalculationRequest) (*pb.GetCalculationResponse, error) {
+	// Include the calculations still waiting to be saved
+	s.recorder.flush()
+
+	record, err := s.history.Get(ctx, req.RequestId)
+	if errors.Is(err, history.ErrNotFound) {
+		return &pb.GetCalculationResponse{
+			Error: &pb.AddResponse_ErrorInfo{
+				Code:     "CALCULATION_NOT_FOUND",
+				Message:  fmt.Sprintf("No calculation recorded for request ID %s", req.RequestId),
+				Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+			},
+		}, err
+	}
+	if err != nil {
+		return &pb.GetCalculationResponse{Error: historyUnavailableError(err)}, err
+	}
+
+	return &pb.GetCalculationResponse{Calculation: toCalculation(record)}, nil
+}
+
+// historyUnavailableError describes a failure of the history repository
+func historyUnavailableError(err error) *pb.AddResponse_ErrorInfo {
+	return &pb.AddResponse_ErrorInfo{
+		Code:     "HISTORY_UNAVAILABLE",
+		Message:  fmt.Sprintf("Calculation history is unavailable: %v", err),
+		Severity: pb.AddResponse_ErrorInfo_SEVERITY_CRITICAL,
+	}
+}
+
+// toCalculation converts a history record into its gRPC form
+func toCalculation(record history.Record) *pb.Calculation {
+	return &pb.Calculation{
+		RequestId:         record.RequestID,
+		Operation:         record.Operation,
+		Request:           string(record.Request),
+		Response:          string(record.Response),
+		ErrorCode:         record.ErrorCode,
+		CalculationMethod: record.CalculationMethod,
+		CalculationTime:   timestamppb.New(record.Time),
+		Duration:          durationpb.New(record.Duration),
+		NumbersProcessed:  record.NumbersProcessed,
+		Truncated:         record.Truncated,
+	}
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pb "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/internal/idempotency"
+)
+
+const (
+	// idempotencyCapacity is the number of responses kept for retries
+	idempotencyCapacity = 10000
+
+	// idempotencyTTL is how long a response is kept for retries
+	idempotencyTTL = 24 * time.Hour
+)
+
+// storedResponse is the outcome of a calculation kept for retries
+type storedResponse struct {
+	response proto.Message
+	err      error
+}
+
+// idempotentRequest is implemented by the requests of calculations that are
+// answered once per request ID
+type idempotentRequest interface {
+	proto.Message
+	GetRequestId() string
+}
+
+// idempotent answers a request carrying a request ID. The response to the
+// first request with that ID is stored and returned for every retry with the
+// same payload; a different payload fails with IDEMPOTENCY_CONFLICT. Retries
+// arriving while the first request is calculated wait for its response. Only
+// the first request is recorded in the history.
+func idempotent[Req idempotentRequest, Resp calculationResponse](
+	s *AdditionService,
+	ctx context.Context,
+	operation string,
+	req Req,
+	calculate func(context.Context, Req) (Resp, error),
+) (Resp, error) {
+	if req.GetRequestId() == "" {
+		return recorded(s, ctx, operation, req, calculate)
+	}
+
+	fingerprint, err := requestFingerprint(req)
+	if err != nil {
+		var zero Resp
+		return zero, err
+	}
+
+	var response Resp
+	stored, replayed, err := s.responses.Do(ctx, req.GetRequestId(), fingerprint, func() (storedResponse, bool) {
+		var err error
+		response, err = recorded(s, ctx, operation, req, calculate)
+		// A cancelled calculation is calculated again on retry
+		cancelled := errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+		return storedResponse{response: proto.Clone(response), err: err}, !cancelled
+	})
+	if errors.Is(err, idempotency.ErrConflict) {
+		return errorResponse[Resp](req.GetRequestId(), &pb.AddResponse_ErrorInfo{
+			Code:     "IDEMPOTENCY_CONFLICT",
+			Message:  fmt.Sprintf("Request ID %s was already used for a different request", req.GetRequestId()),
+			Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+		}), fmt.Errorf("idempotency conflict")
+	}
+	if err != nil {
+		return errorResponse[Resp](req.GetRequestId(), &pb.AddResponse_ErrorInfo{
+			Code:     "REQUEST_CANCELLED",
+			Message:  fmt.Sprintf("Request was cancelled while waiting for the response to request ID %s", req.GetRequestId()),
+			Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+		}), err
+	}
+
+	// The first request gets the response as calculated, retries a copy of
+	// the stored one
+	if !replayed {
+		return response, stored.err
+	}
+	return proto.Clone(stored.response).(Resp), stored.err
+}
+
+// requestFingerprint hashes the type and payload of the request. The request
+// ID and time are left out, as they identify the request rather than its
+// payload.
+func requestFingerprint(req proto.Message) (idempotency.Fingerprint, error) {
+	payload := proto.Clone(req).ProtoReflect()
+	fields := payload.Descriptor().Fields()
+	for _, name := range []protoreflect.Name{"request_id", "request_time"} {
+		if field := fields.ByName(name); field != nil {
+			payload.Clear(field)
+		}
+	}
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(payload.Interface())
+	if err != nil {
+		return idempotency.Fingerprint{}, fmt.Errorf("marshal request: %w", err)
+	}
+	name := payload.Descriptor().FullName()
+	return idempotency.NewFingerprint(append([]byte(name+"\x00"), data...)), nil
+}
+
+// errorResponse creates a response of type Resp carrying only a request ID
+// and an error
+func errorResponse[Resp calculationResponse](requestID string, errInfo *pb.AddResponse_ErrorInfo) Resp {
+	var zero Resp
+	response := zero.ProtoReflect().New()
+	fields := response.Descriptor().Fields()
+	response.Set(fields.ByName("request_id"), protoreflect.ValueOfString(requestID))
+	response.Set(fields.ByName("error"), protoreflect.ValueOfMessage(errInfo.ProtoReflect()))
+	return response.Interface().(Resp)
+}
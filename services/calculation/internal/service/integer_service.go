@@ -0,0 +1,114 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+)
+
+// addInteger sums the integer operands of the request exactly. The sum is
+// accumulated as int64 and every intermediate overflow is detected; it is
+// promoted to math/big only if the request allows it.
+func (s *AdditionService) addInteger(requestID string, req *pb.AddRequest) (*pb.AddResponse, error) {
+	count := len(req.IntegerNumbers) + len(req.BigIntegerNumbers)
+
+	// Collect operands in order, parsing the arbitrary-length ones
+	numbers := make([]*big.Rat, 0, count)
+	for _, num := range req.IntegerNumbers {
+		numbers = append(numbers, new(big.Rat).SetInt64(num))
+	}
+	for i, text := range req.BigIntegerNumbers {
+		num, ok := new(big.Int).SetString(strings.TrimSpace(text), 10)
+		if !ok {
+			return &pb.AddResponse{
+				RequestId: requestID,
+				Error: &pb.AddResponse_ErrorInfo{
+					Code:     "INVALID_INTEGER",
+					Message:  fmt.Sprintf("Big integer number at position %d is invalid: %q", i, text),
+					Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+				},
+			}, fmt.Errorf("invalid integer %q", text)
+		}
+		numbers = append(numbers, new(big.Rat).SetInt(num))
+	}
+
+	// Validate operands against constraints, which keep them integral
+	operands, errInfo, err := validateExact(numbers, nil, true, req.Constraints)
+	if err != nil {
+		return &pb.AddResponse{RequestId: requestID, Error: errInfo}, err
+	}
+
+	// Perform addition, promoting to math/big on overflow if allowed
+	var sum int64
+	var bigSum *big.Int
+	for i, operand := range operands.numbers {
+		num := operand.Num()
+		if bigSum == nil && num.IsInt64() {
+			if result, ok := addInt64(sum, num.Int64()); ok {
+				sum = result
+				continue
+			}
+		}
+
+		if bigSum == nil {
+			if !req.PromoteToBigInteger {
+				return &pb.AddResponse{
+					RequestId: requestID,
+					Error: &pb.AddResponse_ErrorInfo{
+						Code:     "INTEGER_OVERFLOW",
+						Message:  fmt.Sprintf("Sum exceeds the int64 range at operand %d", operands.positions[i]),
+						Severity: pb.AddResponse_ErrorInfo_SEVERITY_CRITICAL,
+					},
+				}, fmt.Errorf("integer overflow")
+			}
+			bigSum = big.NewInt(sum)
+		}
+		bigSum.Add(bigSum, num)
+	}
+
+	method := "integer"
+	if bigSum == nil {
+		bigSum = big.NewInt(sum)
+	} else {
+		method = "big_integer"
+	}
+
+	// Validate the sum against constraints
+	warnings, errInfo, err := exactSumWarnings(new(big.Rat).SetInt(bigSum), operands.warnings, req.Constraints)
+	if err != nil {
+		return &pb.AddResponse{RequestId: requestID, Error: errInfo}, err
+	}
+
+	// Prepare response with calculation metadata
+	approximation, _ := new(big.Float).SetInt(bigSum).Float64()
+	response := &pb.AddResponse{
+		Result:           approximation,
+		BigIntegerResult: bigSum.String(),
+		RequestId:        requestID,
+		CalculationMetadata: &pb.AddResponse_CalculationMetadata{
+			CalculationTime:   timestamppb.Now(),
+			NumbersProcessed:  int32(len(operands.numbers)),
+			CalculationMethod: method,
+			ClampedCount:      int32(operands.clamped),
+			DroppedCount:      int32(operands.dropped),
+			Warnings:          warnings,
+		},
+	}
+	if bigSum.IsInt64() {
+		response.IntegerResult = bigSum.Int64()
+	}
+	return response, nil
+}
+
+// addInt64 adds two int64 values and reports whether the sum overflowed
+func addInt64(a, b int64) (int64, bool) {
+	if (b > 0 && a > math.MaxInt64-b) || (b < 0 && a < math.MinInt64-b) {
+		return 0, false
+	}
+	return a + b, true
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"fmt"
+	"math"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/internal/interval"
+)
+
+// addIntervals sums interval operands with outward rounding so that the
+// resulting interval contains the exact sum
+func (s *AdditionService) addIntervals(requestID string, req *pb.AddRequest) (*pb.AddResponse, error) {
+	// Check max number of numbers
+	if req.Constraints != nil {
+		if errInfo, err := checkMaxNumbers(len(req.IntervalNumbers), req.Constraints); err != nil {
+			return &pb.AddResponse{RequestId: requestID, Error: errInfo}, err
+		}
+	}
+
+	// Build and validate operands
+	operands := make([]interval.Interval, len(req.IntervalNumbers))
+	for i, num := range req.IntervalNumbers {
+		var err error
+		if num.Value != nil {
+			operands[i], err = interval.FromUncertainty(num.GetValue(), num.GetUncertainty())
+		} else {
+			operands[i], err = interval.New(num.GetLo(), num.GetHi())
+		}
+		if err != nil {
+			return &pb.AddResponse{
+				RequestId: requestID,
+				Error: &pb.AddResponse_ErrorInfo{
+					Code:     "INVALID_INTERVAL",
+					Message:  fmt.Sprintf("Interval at position %d is invalid: %v", i, err),
+					Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+				},
+			}, err
+		}
+
+		// Validate min and max values of both bounds
+		if req.Constraints != nil {
+			for _, bound := range []float64{operands[i].Lo, operands[i].Hi} {
+				if errInfo, err := checkRange(bound, req.Constraints); err != nil {
+					return &pb.AddResponse{RequestId: requestID, Error: errInfo}, err
+				}
+			}
+		}
+	}
+
+	// Perform addition
+	sum := interval.Sum(operands)
+
+	// Check for overflow
+	if math.IsInf(sum.Lo, 0) || math.IsInf(sum.Hi, 0) {
+		errInfo, err := overflowError()
+		return &pb.AddResponse{
+			RequestId: requestID,
+			Error:     errInfo,
+		}, err
+	}
+
+	// Prepare response with calculation metadata
+	return &pb.AddResponse{
+		Result:         sum.Midpoint(),
+		IntervalResult: &pb.Interval{Lo: sum.Lo, Hi: sum.Hi},
+		RequestId:      requestID,
+		CalculationMetadata: &pb.AddResponse_CalculationMetadata{
+			CalculationTime:   timestamppb.Now(),
+			NumbersProcessed:  int32(len(req.IntervalNumbers)),
+			CalculationMethod: "interval_arithmetic",
+		},
+	}, nil
+}
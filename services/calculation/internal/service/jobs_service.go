@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/internal/jobs"
+)
+
+const (
+	// jobQueueSize is the number of submitted calculations that can wait for
+	// a free worker
+	jobQueueSize = 1000
+
+	// jobRetention is how long finished jobs can still be looked up
+	jobRetention = time.Hour
+)
+
+// jobOutcome is the response of a submitted calculation
+type jobOutcome struct {
+	add   *pb.AddResponse
+	batch *pb.BatchAddResponse
+}
+
+// SubmitCalculation queues an addition or batch addition to be performed in
+// the background
+func (s *AdditionService) SubmitCalculation(ctx context.Context, req *pb.SubmitCalculationRequest) (*pb.SubmitCalculationResponse, error) {
+	// Exactly one calculation can be submitted per job
+	if (req.Add == nil) == (req.BatchAdd == nil) {
+		return &pb.SubmitCalculationResponse{
+			Error: &pb.AddResponse_ErrorInfo{
+				Code:     "INVALID_JOB",
+				Message:  "Exactly one of add and batch_add must be provided",
+				Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+			},
+		}, fmt.Errorf("invalid job")
+	}
+
+	task := func(ctx context.Context) (jobOutcome, error) {
+		response, err := s.Add(ctx, req.Add)
+		return jobOutcome{add: response}, err
+	}
+	if req.BatchAdd != nil {
+		task = func(ctx context.Context) (jobOutcome, error) {
+			response, err := s.BatchAdd(ctx, req.BatchAdd)
+			return jobOutcome{batch: response}, err
+		}
+	}
+
+	job, err := s.jobs.Submit(task)
+	if err != nil {
+		return &pb.SubmitCalculationResponse{Error: jobError(err, "")}, err
+	}
+	return &pb.SubmitCalculationResponse{Job: toJob(job)}, nil
+}
+
+// GetJob returns the current status of a submitted calculation
+func (s *AdditionService) GetJob(ctx context.Context, req *pb.GetJobRequest) (*pb.GetJobResponse, error) {
+	job, err := s.jobs.Get(req.JobId)
+	if err != nil {
+		return &pb.GetJobResponse{Error: jobError(err, req.JobId)}, err
+	}
+	return &pb.GetJobResponse{Job: toJob(job)}, nil
+}
+
+// CancelJob cancels a submitted calculation. A running calculation is
+// reported as running until it has stopped.
+func (s *AdditionService) CancelJob(ctx context.Context, req *pb.CancelJobRequest) (*pb.CancelJobResponse, error) {
+	job, err := s.jobs.Cancel(req.JobId)
+	if err != nil {
+		return &pb.CancelJobResponse{Error: jobError(err, req.JobId)}, err
+	}
+	return &pb.CancelJobResponse{Job: toJob(job)}, nil
+}
+
+// WaitJob waits until a submitted calculation has finished, the timeout has
+// passed or the call is cancelled, and returns its status at that time
+func (s *AdditionService) WaitJob(ctx context.Context, req *pb.WaitJobRequest) (*pb.WaitJobResponse, error) {
+	// Validate timeout
+	if req.TimeoutMs < 0 {
+		return &pb.WaitJobResponse{
+			Error: &pb.AddResponse_ErrorInfo{
+				Code:     "INVALID_TIMEOUT",
+				Message:  fmt.Sprintf("Timeout %dms is negative", req.TimeoutMs),
+				Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+			},
+		}, fmt.Errorf("invalid timeout")
+	}
+	if req.TimeoutMs > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(req.TimeoutMs)*time.Millisecond)
+		defer cancel()
+	}
+
+	job, err := s.jobs.Wait(ctx, req.JobId)
+	if err != nil {
+		return &pb.WaitJobResponse{Error: jobError(err, req.JobId)}, err
+	}
+	return &pb.WaitJobResponse{Job: toJob(job)}, nil
+}
+
+// jobError describes a failure of the job pool
+func jobError(err error, jobID string) *pb.AddResponse_ErrorInfo {
+	switch {
+	case errors.Is(err, jobs.ErrNotFound):
+		return &pb.AddResponse_ErrorInfo{
+			Code:     "JOB_NOT_FOUND",
+			Message:  fmt.Sprintf("No job with ID %s", jobID),
+			Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+		}
+	case errors.Is(err, jobs.ErrQueueFull):
+		return &pb.AddResponse_ErrorInfo{
+			Code:     "QUEUE_FULL",
+			Message:  fmt.Sprintf("Job queue is full, at most %d jobs can wait", jobQueueSize),
+			Severity: pb.AddResponse_ErrorInfo_SEVERITY_WARNING,
+		}
+	default:
+		return &pb.AddResponse_ErrorInfo{
+			Code:     "SERVICE_CLOSED",
+			Message:  "Service no longer accepts jobs",
+			Severity: pb.AddResponse_ErrorInfo_SEVERITY_CRITICAL,
+		}
+	}
+}
+
+// toJob converts a job snapshot into its gRPC form
+func toJob(job jobs.Job[jobOutcome]) *pb.Job {
+	result := &pb.Job{
+		JobId:            job.ID,
+		Status:           toJobStatus(job.Status),
+		SubmitTime:       timestamppb.New(job.Submitted),
+		AddResponse:      job.Result.add,
+		BatchAddResponse: job.Result.batch,
+	}
+	if !job.Started.IsZero() {
+		result.StartTime = timestamppb.New(job.Started)
+	}
+	if !job.Finished.IsZero() {
+		result.EndTime = timestamppb.New(job.Finished)
+	}
+	return result
+}
+
+// toJobStatus converts a job status into its gRPC form
+func toJobStatus(status jobs.Status) pb.JobStatus {
+	switch status {
+	case jobs.Queued:
+		return pb.JobStatus_JOB_STATUS_QUEUED
+	case jobs.Running:
+		return pb.JobStatus_JOB_STATUS_RUNNING
+	case jobs.Done:
+		return pb.JobStatus_JOB_STATUS_DONE
+	case jobs.Failed:
+		return pb.JobStatus_JOB_STATUS_FAILED
+	case jobs.Cancelled:
+		return pb.JobStatus_JOB_STATUS_CANCELLED
+	default:
+		return pb.JobStatus_JOB_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/internal/decimal"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/internal/money"
+)
+
+// addMoney sums monetary amounts exactly and rounds the sum to the minor unit
+// of the result currency
+func (s *AdditionService) addMoney(requestID string, req *pb.AddRequest) (*pb.AddResponse, error) {
+	errorResponse := func(code, message string, err error) (*pb.AddResponse, error) {
+		return &pb.AddResponse{
+			RequestId: requestID,
+			Error: &pb.AddResponse_ErrorInfo{
+				Code:     code,
+				Message:  message,
+				Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+			},
+		}, err
+	}
+
+	// Validate money options
+	options := req.MoneyOptions
+	appliedMode := appliedRoundingMode(options.GetRoundingMode())
+	mode, ok := roundingModes[appliedMode]
+	if !ok {
+		return errorResponse("INVALID_ROUNDING_MODE", fmt.Sprintf("Unsupported rounding mode %s", appliedMode),
+			fmt.Errorf("invalid rounding mode"))
+	}
+
+	// Resolve the result currency
+	resultCode := req.MoneyNumbers[0].GetCurrency()
+	if options.GetCurrency() != "" {
+		resultCode = options.GetCurrency()
+	}
+	resultCurrency, err := money.LookupCurrency(resultCode)
+	if err != nil {
+		return errorResponse("UNKNOWN_CURRENCY", fmt.Sprintf("Unknown result currency %q", resultCode), err)
+	}
+
+	// Parse operands
+	amounts := make([]*big.Rat, len(req.MoneyNumbers))
+	currencies := make([]money.Currency, len(req.MoneyNumbers))
+	codes := make([]string, len(req.MoneyNumbers))
+	for i, operand := range req.MoneyNumbers {
+		currency, err := money.LookupCurrency(operand.GetCurrency())
+		if err != nil {
+			return errorResponse("UNKNOWN_CURRENCY", fmt.Sprintf("Unknown currency %q at position %d", operand.GetCurrency(), i), err)
+		}
+
+		amount, err := money.ParseAmount(operand.GetAmount(), currency)
+		if err != nil {
+			return errorResponse("INVALID_AMOUNT", fmt.Sprintf("Amount at position %d is invalid: %v", i, err), err)
+		}
+		amounts[i], currencies[i], codes[i] = amount, currency, currency.Code
+	}
+
+	// Validate amounts against constraints, telling apart equal amounts in
+	// different currencies
+	operands, errInfo, err := validateExact(amounts, codes, false, req.Constraints)
+	if err != nil {
+		return &pb.AddResponse{RequestId: requestID, Error: errInfo}, err
+	}
+
+	// Convert operands, then add them exactly
+	sum := new(big.Rat)
+	for j, amount := range operands.numbers {
+		i := operands.positions[j]
+		currency := currencies[i]
+		if currency.Code != resultCurrency.Code && !options.GetConvertCurrencies() {
+			return errorResponse("CURRENCY_MISMATCH", fmt.Sprintf("Amount at position %d is in %s, expected %s",
+				i, currency.Code, resultCurrency.Code), fmt.Errorf("currency mismatch"))
+		}
+
+		converted, err := s.rates.Convert(amount, currency, resultCurrency)
+		var missing *money.MissingRateError
+		if errors.As(err, &missing) {
+			return errorResponse("MISSING_EXCHANGE_RATE", fmt.Sprintf("No exchange rate from %s to %s",
+				missing.From, missing.To), err)
+		}
+
+		sum.Add(sum, converted)
+	}
+
+	// Round to the minor unit of the result currency
+	sum = decimal.Round(sum, resultCurrency.MinorUnits, mode)
+	approximation, _ := sum.Float64()
+
+	// Validate the sum against constraints
+	warnings, errInfo, err := exactSumWarnings(sum, operands.warnings, req.Constraints)
+	if err != nil {
+		return &pb.AddResponse{RequestId: requestID, Error: errInfo}, err
+	}
+
+	// Prepare response with calculation metadata
+	return &pb.AddResponse{
+		Result: approximation,
+		MoneyResult: &pb.Money{
+			Currency: resultCurrency.Code,
+			Amount:   decimal.Format(sum, resultCurrency.MinorUnits),
+		},
+		RequestId: requestID,
+		CalculationMetadata: &pb.AddResponse_CalculationMetadata{
+			CalculationTime:   timestamppb.Now(),
+			NumbersProcessed:  int32(len(operands.numbers)),
+			CalculationMethod: "money",
+			RoundingMode:      appliedMode,
+			ClampedCount:      int32(operands.clamped),
+			DroppedCount:      int32(operands.dropped),
+			Warnings:          warnings,
+		},
+	}, nil
+}
+
+// LoadExchangeRates replaces the exchange rates used to convert money
+// operands with the table read from a JSON file
+func (s *AdditionService) LoadExchangeRates(path string) error {
+	return s.rates.LoadFile(path)
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/internal/units"
+)
+
+// addQuantities converts numbers carrying units into the result unit and
+// adds them
+func (s *AdditionService) addQuantities(ctx context.Context, requestID string, req *pb.AddRequest) (*pb.AddResponse, error) {
+	errorResponse := func(code, message string, err error) (*pb.AddResponse, error) {
+		return &pb.AddResponse{
+			RequestId: requestID,
+			Error: &pb.AddResponse_ErrorInfo{
+				Code:     code,
+				Message:  message,
+				Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+			},
+		}, err
+	}
+
+	// Validate numbers against constraints
+	operands, errInfo, err := validateNumbers(req.Numbers, req.Constraints)
+	if err != nil {
+		return &pb.AddResponse{
+			RequestId: requestID,
+			Error:     errInfo,
+		}, err
+	}
+
+	// Every number needs a unit
+	if len(req.Units) != len(req.Numbers) {
+		return errorResponse("INVALID_UNITS", fmt.Sprintf("Got %d units for %d numbers", len(req.Units), len(req.Numbers)),
+			fmt.Errorf("units do not match numbers"))
+	}
+
+	// Resolve the result unit
+	resultSymbol := req.Units[0]
+	if req.ResultUnit != nil {
+		resultSymbol = req.GetResultUnit()
+	}
+	resultUnit, err := s.units.Lookup(resultSymbol)
+	if err != nil {
+		return errorResponse("UNKNOWN_UNIT", fmt.Sprintf("Unknown result unit %q", resultSymbol), err)
+	}
+
+	// Convert every validated number into the result unit
+	converted := make([]float64, 0, len(operands.numbers))
+	for j, num := range operands.numbers {
+		i := operands.positions[j]
+		unit, err := s.units.Lookup(req.Units[i])
+		if err != nil {
+			return errorResponse("UNKNOWN_UNIT", fmt.Sprintf("Unknown unit %q at position %d", req.Units[i], i), err)
+		}
+
+		value, err := units.Convert(num, unit, resultUnit)
+		var incompatible *units.IncompatibleUnitsError
+		if errors.As(err, &incompatible) {
+			return errorResponse("UNIT_MISMATCH", fmt.Sprintf("Unit %s (%s) at position %d cannot be combined with %s (%s)",
+				unit.Symbol, unit.Dimension, i, resultUnit.Symbol, resultUnit.Dimension), err)
+		}
+
+		// Only operands that already were infinite may propagate
+		if math.IsInf(value, 0) && !math.IsInf(num, 0) {
+			errInfo, err := overflowError()
+			errInfo.Message = fmt.Sprintf("Converting number at position %d from %s to %s resulted in infinity",
+				i, unit.Symbol, resultUnit.Symbol)
+			return &pb.AddResponse{
+				RequestId: requestID,
+				Error:     errInfo,
+			}, err
+		}
+		converted = append(converted, value)
+	}
+
+	// Select summation algorithm
+	algorithm, ok := selectAlgorithm(req.Algorithm, len(converted))
+	if !ok {
+		return errorResponse("INVALID_ALGORITHM", fmt.Sprintf("Unsupported summation algorithm %s", req.Algorithm),
+			fmt.Errorf("invalid summation algorithm"))
+	}
+
+	// Perform addition, stopping early if the call is cancelled
+	result, err := algorithm.sum(ctx, converted)
+	if err != nil {
+		errInfo, err := cancelledError(err)
+		return &pb.AddResponse{
+			RequestId: requestID,
+			Error:     errInfo,
+		}, err
+	}
+
+	// Check for overflow and undefined results. Only non-finite request
+	// operands propagate, conversions were checked above.
+	if errInfo, err := checkResult(result, operands.numbers); err != nil {
+		return &pb.AddResponse{
+			RequestId: requestID,
+			Error:     errInfo,
+		}, err
+	}
+
+	// Validate the sum against constraints
+	warnings := operands.warnings
+	if req.Constraints != nil {
+		if errInfo, err := checkSum(result, req.Constraints); err != nil {
+			warning, ok := warnOnly(errInfo, req.Constraints)
+			if !ok {
+				return &pb.AddResponse{
+					RequestId: requestID,
+					Error:     errInfo,
+				}, err
+			}
+			warnings = append(warnings, warning)
+		}
+	}
+
+	// Prepare response with calculation metadata
+	return &pb.AddResponse{
+		Result:    result,
+		Unit:      resultUnit.Symbol,
+		RequestId: requestID,
+		CalculationMetadata: &pb.AddResponse_CalculationMetadata{
+			CalculationTime:   timestamppb.Now(),
+			NumbersProcessed:  int32(len(converted)),
+			ClampedCount:      int32(operands.clamped),
+			DroppedCount:      int32(operands.dropped),
+			Warnings:          warnings,
+			CalculationMethod: algorithm.method,
+		},
+	}, nil
+}
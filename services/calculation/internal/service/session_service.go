@@ -0,0 +1,203 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+)
+
+// calculatorSession holds the state of a single Session stream
+type calculatorSession struct {
+	accumulator float64
+	operands    int
+	clamped     int
+	dropped     int
+	constraints *pb.AddRequest_Constraints
+	// seen maps every operand added since the last clear to its position,
+	// for the unique constraint
+	seen map[float64]int
+}
+
+// Session keeps an accumulator for the lifetime of the stream and replies to
+// every operation with the updated value. Failed operations are reported in
+// the reply and leave the accumulator unchanged.
+func (s *AdditionService) Session(stream pb.AdditionService_SessionServer) error {
+	session := &calculatorSession{seen: make(map[float64]int)}
+
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		start := time.Now()
+		response := session.apply(req)
+		s.record("session", req, response, start, time.Since(start))
+
+		if err := stream.Send(response); err != nil {
+			return err
+		}
+	}
+}
+
+// apply performs a single session operation
+func (c *calculatorSession) apply(req *pb.SessionRequest) *pb.SessionResponse {
+	// Validate request ID
+	requestID := req.RequestId
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+
+	// failed reports an error without touching the accumulator
+	failed := func(errInfo *pb.AddResponse_ErrorInfo, _ error) *pb.SessionResponse {
+		return &pb.SessionResponse{
+			Result:    c.accumulator,
+			RequestId: requestID,
+			Error:     errInfo,
+		}
+	}
+
+	// Constraints apply to this and all following operations
+	if req.Constraints != nil {
+		if errInfo, err := checkActions(req.Constraints); err != nil {
+			return failed(errInfo, err)
+		}
+		c.constraints = req.Constraints
+	}
+
+	var warnings []*pb.AddResponse_ErrorInfo
+	switch req.Operation {
+	case pb.SessionRequest_OPERATION_ADD, pb.SessionRequest_OPERATION_SUBTRACT:
+		clamped := c.clamped
+		value, keep, errInfo, err := c.validate(req.Value)
+		if err != nil {
+			return failed(errInfo, err)
+		}
+		if !keep {
+			break
+		}
+
+		result := c.accumulator + value
+		if req.Operation == pb.SessionRequest_OPERATION_SUBTRACT {
+			result = c.accumulator - value
+		}
+
+		// Check for overflow and undefined results of finite operands
+		if errInfo, err := checkResult(result, []float64{c.accumulator, value}); err != nil {
+			c.clamped = clamped
+			return failed(errInfo, err)
+		}
+
+		c.accumulator = result
+		if c.constraints.GetUnique() && !math.IsNaN(value) {
+			c.seen[value] = c.operands
+		}
+		c.operands++
+	case pb.SessionRequest_OPERATION_CLEAR:
+		c.accumulator = 0
+		c.operands = 0
+		c.clamped = 0
+		c.dropped = 0
+		clear(c.seen)
+	case pb.SessionRequest_OPERATION_TOTAL:
+		// The constraints on all operands apply to the total
+		if c.constraints != nil {
+			for _, check := range []func() (*pb.AddResponse_ErrorInfo, error){
+				func() (*pb.AddResponse_ErrorInfo, error) { return checkMinNumbers(c.operands, c.constraints) },
+				func() (*pb.AddResponse_ErrorInfo, error) { return checkSum(c.accumulator, c.constraints) },
+			} {
+				if errInfo, err := check(); err != nil {
+					warning, ok := warnOnly(errInfo, c.constraints)
+					if !ok {
+						return failed(errInfo, err)
+					}
+					warnings = append(warnings, warning)
+				}
+			}
+		}
+	default:
+		return failed(&pb.AddResponse_ErrorInfo{
+			Code:     "INVALID_OPERATION",
+			Message:  fmt.Sprintf("Unsupported session operation %s", req.Operation),
+			Severity: pb.AddResponse_ErrorInfo_SEVERITY_ERROR,
+		}, nil)
+	}
+
+	// Prepare response with calculation metadata
+	return &pb.SessionResponse{
+		Result:    c.accumulator,
+		RequestId: requestID,
+		CalculationMetadata: &pb.AddResponse_CalculationMetadata{
+			CalculationTime:   timestamppb.Now(),
+			NumbersProcessed:  int32(c.operands),
+			ClampedCount:      int32(c.clamped),
+			DroppedCount:      int32(c.dropped),
+			CalculationMethod: "session",
+			Warnings:          warnings,
+		},
+	}
+}
+
+// validate checks an operand against the session constraints as
+// validateNumbers does for a request. It returns the value to calculate with
+// and whether to keep it, counting clamped and dropped operands.
+func (c *calculatorSession) validate(num float64) (float64, bool, *pb.AddResponse_ErrorInfo, error) {
+	// Apply the NaN and infinity policies; a skipped operand leaves the
+	// accumulator unchanged
+	keep, errInfo, err := checkNonFinite(num, c.operands, c.constraints)
+	if err != nil || !keep {
+		if finiteOnlyDrops(num, c.constraints) {
+			c.dropped++
+		}
+		return num, false, errInfo, err
+	}
+	if c.constraints == nil {
+		return num, true, nil, nil
+	}
+
+	// Validate the number of operands
+	if errInfo, err := checkMaxNumbers(c.operands+1, c.constraints); err != nil {
+		if c.constraints.GetMaxNumbersAction() != pb.ConstraintAction_CONSTRAINT_ACTION_DROP {
+			return num, false, errInfo, err
+		}
+		c.dropped++
+		return num, false, nil, nil
+	}
+
+	// Validate the value of the operand, clamping or dropping it if the
+	// violated constraint says so
+	value, action, errInfo, err := resolveOperand(num, c.constraints)
+	if err != nil {
+		return num, false, errInfo, err
+	}
+	switch action {
+	case pb.ConstraintAction_CONSTRAINT_ACTION_DROP:
+		c.dropped++
+		return num, false, nil, nil
+	case pb.ConstraintAction_CONSTRAINT_ACTION_CLAMP:
+		c.clamped++
+	}
+
+	// Validate uniqueness
+	if c.constraints.GetUnique() {
+		if previous, ok := c.seen[value]; ok {
+			if c.constraints.GetUniqueAction() == pb.ConstraintAction_CONSTRAINT_ACTION_DROP {
+				c.dropped++
+				return num, false, nil, nil
+			}
+			errInfo, err := duplicateValueError(value, c.operands, previous)
+			return num, false, errInfo, err
+		}
+	}
+	return value, true, nil, nil
+}
@@ -0,0 +1,370 @@
+package service
+
+import (
+	"errors"
+	"io"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/internal/summation"
+)
+
+// AddStream adds numbers received in chunks over a client stream. The request
+// ID and constraints are taken from the first chunk and enforced across the
+// whole stream.
+func (s *AdditionService) AddStream(stream pb.AdditionService_AddStreamServer) error {
+	start := time.Now()
+	recording := &recordingAddStream{AdditionService_AddStreamServer: stream}
+	response, err := s.addStream(recording)
+	if response != nil {
+		s.record("add_stream", recording.first, response, start, time.Since(start))
+	}
+	if err != nil {
+		// gRPC discards the response of a failed stream, so it travels in
+		// the status instead
+		return withResponse(err, response)
+	}
+	return stream.SendAndClose(response)
+}
+
+// recordingAddStream keeps the first chunk of an AddStream call for the
+// history
+type recordingAddStream struct {
+	pb.AdditionService_AddStreamServer
+	first *pb.AddStreamRequest
+}
+
+// Recv receives a chunk, keeping the first one
+func (r *recordingAddStream) Recv() (*pb.AddStreamRequest, error) {
+	chunk, err := r.AdditionService_AddStreamServer.Recv()
+	if err == nil && r.first == nil {
+		r.first = chunk
+	}
+	return chunk, err
+}
+
+// addStream performs the addition of AddStream, returning the response to
+// send once the client has sent every chunk
+func (s *AdditionService) addStream(stream pb.AdditionService_AddStreamServer) (*pb.AddResponse, error) {
+	var (
+		requestID   string
+		constraints *pb.AddRequest_Constraints
+		collected   = &violations{}
+		result      float64
+		blocks      summation.Blocks
+		count       int
+		processed   int
+		clamped     int
+		dropped     int
+		propagated  bool
+		dropExtra   bool
+		tooMany     bool
+		seen        = make(map[float64]int)
+		first       = true
+		warnings    []*pb.AddResponse_ErrorInfo
+	)
+
+	// fail describes the error in the response of the stream
+	fail := func(errInfo *pb.AddResponse_ErrorInfo, err error) (*pb.AddResponse, error) {
+		if requestID == "" {
+			requestID = uuid.New().String()
+		}
+		return &pb.AddResponse{
+			RequestId: requestID,
+			Error:     errInfo,
+		}, err
+	}
+
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// Read request ID and constraints from the first chunk
+		if first {
+			requestID = chunk.RequestId
+			constraints = chunk.Constraints
+			first = false
+			if errInfo, err := checkActions(constraints); err != nil {
+				return fail(errInfo, err)
+			}
+			collected.collectAll = constraints.GetValidationMode() == pb.ValidationMode_VALIDATION_MODE_COLLECT_ALL
+			dropExtra = constraints.GetMaxNumbersAction() == pb.ConstraintAction_CONSTRAINT_ACTION_DROP &&
+				constraints.MaxNumbers != nil
+		}
+
+		// Validate chunk against constraints
+		offset := count
+		count += len(chunk.Numbers)
+		if constraints != nil && !dropExtra && !tooMany {
+			if errInfo, err := checkMaxNumbers(count, constraints); err != nil {
+				tooMany = true
+				// The first extra operand is only known if this chunk has
+				// it; an empty chunk after a negative maximum has not
+				extra := max(int(constraints.GetMaxNumbers()), 0)
+				var value float64
+				if extra >= offset && extra-offset < len(chunk.Numbers) {
+					value = chunk.Numbers[extra-offset]
+				}
+				if !collected.add(extra, value, errInfo) {
+					return fail(errInfo, err)
+				}
+			}
+		}
+
+		// Accumulate chunk, clamping or dropping operands if the violated
+		// constraint says so
+		for j, num := range chunk.Numbers {
+			position := offset + j
+			keep, errInfo, err := checkNonFinite(num, position, constraints)
+			if err != nil {
+				if !collected.add(position, num, errInfo) {
+					return fail(errInfo, err)
+				}
+				continue
+			}
+			if !keep {
+				if finiteOnlyDrops(num, constraints) {
+					dropped++
+				}
+				continue
+			}
+
+			if constraints != nil {
+				value, action, errInfo, err := resolveOperand(num, constraints)
+				if err != nil {
+					if !collected.add(position, num, errInfo) {
+						return fail(errInfo, err)
+					}
+					continue
+				}
+				switch action {
+				case pb.ConstraintAction_CONSTRAINT_ACTION_DROP:
+					dropped++
+					continue
+				case pb.ConstraintAction_CONSTRAINT_ACTION_CLAMP:
+					clamped++
+				}
+				num = value
+			}
+			if constraints.GetUnique() && !math.IsNaN(num) {
+				if previous, ok := seen[num]; ok {
+					if constraints.GetUniqueAction() == pb.ConstraintAction_CONSTRAINT_ACTION_DROP {
+						dropped++
+						continue
+					}
+					errInfo, err := duplicateValueError(num, position, previous)
+					if !collected.add(position, num, errInfo) {
+						return fail(errInfo, err)
+					}
+					continue
+				}
+				seen[num] = position
+			}
+			if dropExtra && processed >= int(constraints.GetMaxNumbers()) {
+				dropped++
+				continue
+			}
+
+			result += num
+			blocks.Add(num)
+			propagated = propagated || math.IsInf(num, 0) || math.IsNaN(num)
+			processed++
+		}
+	}
+
+	// Validate request
+	if count == 0 {
+		return fail(noNumbersError())
+	}
+	if constraints != nil {
+		if errInfo, err := checkMinNumbers(count, constraints); err != nil {
+			if warning, ok := warnOnly(errInfo, constraints); ok {
+				warnings = append(warnings, warning)
+			} else if !collected.add(-1, 0, errInfo) {
+				return fail(errInfo, err)
+			}
+		}
+	}
+	if errInfo, err := collected.result(); err != nil {
+		return fail(errInfo, err)
+	}
+	if processed == 0 {
+		return fail(noNumbersError())
+	}
+
+	// Add long streams like a unary Add of the same operands, in blocks that
+	// give the same result as PARALLEL
+	method := "simple_addition"
+	if processed >= parallelThreshold {
+		result = blocks.Sum()
+		method = "parallel_pairwise_summation"
+	}
+
+	// Check for overflow and undefined results
+	if !propagated {
+		if errInfo, err := checkResult(result, nil); err != nil {
+			return fail(errInfo, err)
+		}
+	}
+
+	// Validate the sum against constraints
+	if constraints != nil {
+		if errInfo, err := checkSum(result, constraints); err != nil {
+			warning, ok := warnOnly(errInfo, constraints)
+			if !ok {
+				return fail(errInfo, err)
+			}
+			warnings = append(warnings, warning)
+		}
+	}
+
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+
+	// Prepare response with calculation metadata
+	return &pb.AddResponse{
+		Result:    result,
+		RequestId: requestID,
+		CalculationMetadata: &pb.AddResponse_CalculationMetadata{
+			CalculationTime:   timestamppb.Now(),
+			NumbersProcessed:  int32(processed),
+			ClampedCount:      int32(clamped),
+			DroppedCount:      int32(dropped),
+			CalculationMethod: method,
+			Warnings:          warnings,
+		},
+	}, nil
+}
+
+// AddProgress adds the numbers in the request and streams the running total
+// after every report_every_numbers operands or report_interval_ms
+// milliseconds, followed by the final result
+func (s *AdditionService) AddProgress(req *pb.AddProgressRequest, stream pb.AdditionService_AddProgressServer) error {
+	start := time.Now()
+	recording := &recordingAddProgress{AdditionService_AddProgressServer: stream}
+	err := s.addProgress(req, recording)
+	if recording.last != nil && !recording.last.GetCalculationMetadata().GetPartial() {
+		s.record("add_progress", req, recording.last, start, time.Since(start))
+	}
+	return err
+}
+
+// recordingAddProgress keeps the last response of an AddProgress call for the
+// history. Calls ending before the final result or an error are not recorded.
+type recordingAddProgress struct {
+	pb.AdditionService_AddProgressServer
+	last *pb.AddResponse
+}
+
+// Send sends a response, keeping it
+func (r *recordingAddProgress) Send(response *pb.AddResponse) error {
+	r.last = response
+	return r.AdditionService_AddProgressServer.Send(response)
+}
+
+// addProgress performs the addition of AddProgress
+func (s *AdditionService) addProgress(req *pb.AddProgressRequest, stream pb.AdditionService_AddProgressServer) error {
+	// Validate request ID
+	requestID := req.RequestId
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+
+	// fail sends the error details to the client and ends the stream
+	fail := func(errInfo *pb.AddResponse_ErrorInfo, err error) error {
+		if sendErr := stream.Send(&pb.AddResponse{
+			RequestId: requestID,
+			Error:     errInfo,
+		}); sendErr != nil {
+			return sendErr
+		}
+		return err
+	}
+
+	// Validate numbers against constraints
+	operands, errInfo, err := validateNumbers(req.Numbers, req.Constraints)
+	if err != nil {
+		return fail(errInfo, err)
+	}
+	numbers := operands.numbers
+	warnings := operands.warnings
+
+	// report sends the running total to the client
+	report := func(result float64, processed int, partial bool) error {
+		return stream.Send(&pb.AddResponse{
+			Result:    result,
+			RequestId: requestID,
+			CalculationMetadata: &pb.AddResponse_CalculationMetadata{
+				CalculationTime:   timestamppb.Now(),
+				NumbersProcessed:  int32(processed),
+				ClampedCount:      int32(operands.clamped),
+				DroppedCount:      int32(operands.dropped),
+				CalculationMethod: "simple_addition",
+				Partial:           partial,
+				Warnings:          warnings,
+			},
+		})
+	}
+
+	interval := time.Duration(req.ReportIntervalMs) * time.Millisecond
+	lastReport := time.Now()
+
+	// Perform addition, reporting progress along the way
+	var (
+		result     float64
+		propagated bool
+	)
+	for i, num := range numbers {
+		result += num
+		processed := i + 1
+
+		// Check for overflow and undefined results, which propagated
+		// non-finite operands may cause
+		propagated = propagated || math.IsInf(num, 0) || math.IsNaN(num)
+		if !propagated {
+			if errInfo, err := checkResult(result, nil); err != nil {
+				return fail(errInfo, err)
+			}
+		}
+
+		if processed == len(numbers) {
+			break
+		}
+
+		dueByCount := req.ReportEveryNumbers > 0 && processed%int(req.ReportEveryNumbers) == 0
+		dueByTime := interval > 0 && time.Since(lastReport) >= interval
+		if dueByCount || dueByTime {
+			if err := stream.Context().Err(); err != nil {
+				return err
+			}
+			if err := report(result, processed, true); err != nil {
+				return err
+			}
+			lastReport = time.Now()
+		}
+	}
+
+	// Validate the sum against constraints
+	if req.Constraints != nil {
+		if errInfo, err := checkSum(result, req.Constraints); err != nil {
+			warning, ok := warnOnly(errInfo, req.Constraints)
+			if !ok {
+				return fail(errInfo, err)
+			}
+			warnings = append(warnings, warning)
+		}
+	}
+
+	// Send final result
+	return report(result, len(numbers), false)
+}
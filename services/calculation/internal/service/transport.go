@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// UnaryServerInterceptor attaches the response of a failed call to the gRPC
+// status it returns. gRPC discards the response of a call that fails, so
+// without it clients would only see the error message, not the ErrorInfo
+// describing the failure.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err == nil {
+			return resp, nil
+		}
+		return resp, withResponse(err, resp)
+	}
+}
+
+// withResponse converts err into a gRPC status carrying the response as a
+// detail. Errors without a response are returned unchanged.
+func withResponse(err error, resp interface{}) error {
+	message, ok := resp.(proto.Message)
+	if !ok || !message.ProtoReflect().IsValid() {
+		return err
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		st = status.FromContextError(err)
+	}
+	detailed, detailsErr := st.WithDetails(protoadapt.MessageV1Of(message))
+	if detailsErr != nil {
+		return err
+	}
+	return detailed.Err()
+}
@@ -0,0 +1,78 @@
+// Package statistics computes descriptive statistics of a sample.
+package statistics
+
+import (
+	"math"
+	"sort"
+)
+
+// Summary holds the descriptive statistics of a sample
+type Summary struct {
+	Count          int
+	Sum            float64
+	Mean           float64
+	Variance       float64
+	SampleVariance float64
+	Min            float64
+	Max            float64
+
+	sorted []float64
+}
+
+// Describe computes the statistics of numbers, which must not be empty.
+// Mean and variance use Welford's online algorithm for numerical stability.
+func Describe(numbers []float64) *Summary {
+	s := &Summary{
+		Count: len(numbers),
+		Min:   math.Inf(1),
+		Max:   math.Inf(-1),
+	}
+
+	var m2 float64
+	for i, num := range numbers {
+		s.Sum += num
+		s.Min = math.Min(s.Min, num)
+		s.Max = math.Max(s.Max, num)
+
+		delta := num - s.Mean
+		s.Mean += delta / float64(i+1)
+		m2 += delta * (num - s.Mean)
+	}
+
+	s.Variance = m2 / float64(s.Count)
+	if s.Count > 1 {
+		s.SampleVariance = m2 / float64(s.Count-1)
+	}
+
+	s.sorted = append([]float64(nil), numbers...)
+	sort.Float64s(s.sorted)
+	return s
+}
+
+// StandardDeviation returns the population standard deviation
+func (s *Summary) StandardDeviation() float64 {
+	return math.Sqrt(s.Variance)
+}
+
+// SampleStandardDeviation returns the sample standard deviation
+func (s *Summary) SampleStandardDeviation() float64 {
+	return math.Sqrt(s.SampleVariance)
+}
+
+// Median returns the 50th percentile
+func (s *Summary) Median() float64 {
+	return s.Percentile(50)
+}
+
+// Percentile returns the p-th percentile (0 to 100), linearly interpolated
+// between the closest ranks
+func (s *Summary) Percentile(p float64) float64 {
+	rank := p / 100 * float64(len(s.sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	if lower == upper {
+		return s.sorted[lower]
+	}
+	fraction := rank - float64(lower)
+	return s.sorted[lower] + fraction*(s.sorted[upper]-s.sorted[lower])
+}
@@ -0,0 +1,201 @@
+// Package summation implements floating point summation algorithms with
+// different accuracy and performance trade-offs.
+package summation
+
+import (
+	"context"
+	"math"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// pairwiseBlockSize is the length below which Pairwise adds naively
+const pairwiseBlockSize = 8
+
+// parallelBlockSize is the number of operands Parallel adds per block. It is
+// fixed so that the result does not depend on the number of goroutines.
+const parallelBlockSize = 1 << 16
+
+// checkInterval is the number of operands added between checks whether the
+// context was cancelled. Checking between chunks does not change the order of
+// the additions, so results do not depend on it.
+const checkInterval = 1 << 16
+
+// Naive adds the numbers left to right
+func Naive(ctx context.Context, numbers []float64) (float64, error) {
+	var sum float64
+	for start := 0; start < len(numbers); start += checkInterval {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		for _, num := range numbers[start:min(start+checkInterval, len(numbers))] {
+			sum += num
+		}
+	}
+	return sum, nil
+}
+
+// Kahan adds the numbers using Kahan compensated summation
+func Kahan(ctx context.Context, numbers []float64) (float64, error) {
+	var sum, c float64
+	for start := 0; start < len(numbers); start += checkInterval {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		for _, num := range numbers[start:min(start+checkInterval, len(numbers))] {
+			y := num - c
+			t := sum + y
+			c = (t - sum) - y
+			sum = t
+		}
+	}
+	return sum, nil
+}
+
+// Neumaier adds the numbers using the Kahan-Babuška-Neumaier algorithm,
+// which also compensates when an operand is larger than the running sum
+func Neumaier(ctx context.Context, numbers []float64) (float64, error) {
+	var sum, c float64
+	for start := 0; start < len(numbers); start += checkInterval {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		for _, num := range numbers[start:min(start+checkInterval, len(numbers))] {
+			t := sum + num
+			if math.Abs(sum) >= math.Abs(num) {
+				c += (sum - t) + num
+			} else {
+				c += (num - t) + sum
+			}
+			sum = t
+		}
+	}
+
+	// The compensation is meaningless once the sum has overflowed
+	if math.IsInf(sum, 0) {
+		return sum, nil
+	}
+	return sum + c, nil
+}
+
+// Pairwise adds the numbers by recursively splitting them in halves
+func Pairwise(ctx context.Context, numbers []float64) (float64, error) {
+	if len(numbers) <= checkInterval {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		return pairwise(numbers), nil
+	}
+	mid := len(numbers) / 2
+	left, err := Pairwise(ctx, numbers[:mid])
+	if err != nil {
+		return 0, err
+	}
+	right, err := Pairwise(ctx, numbers[mid:])
+	if err != nil {
+		return 0, err
+	}
+	return left + right, nil
+}
+
+// pairwise adds the numbers like Pairwise without checking for cancellation
+func pairwise(numbers []float64) float64 {
+	if len(numbers) <= pairwiseBlockSize {
+		var sum float64
+		for _, num := range numbers {
+			sum += num
+		}
+		return sum
+	}
+	mid := len(numbers) / 2
+	return pairwise(numbers[:mid]) + pairwise(numbers[mid:])
+}
+
+// Parallel adds the numbers on all available CPUs. The numbers are split into
+// fixed-size blocks that are added pairwise, and the block sums are combined
+// by pairwise reduction, so the result is bit-for-bit the same for any number
+// of goroutines.
+func Parallel(ctx context.Context, numbers []float64) (float64, error) {
+	return parallel(ctx, numbers, runtime.GOMAXPROCS(0))
+}
+
+// parallel adds the numbers like Parallel using at most workers goroutines
+func parallel(ctx context.Context, numbers []float64, workers int) (float64, error) {
+	blocks := (len(numbers) + parallelBlockSize - 1) / parallelBlockSize
+	if blocks <= 1 || workers <= 1 {
+		sums, err := blockSums(ctx, numbers)
+		if err != nil {
+			return 0, err
+		}
+		return pairwise(sums), nil
+	}
+
+	// Goroutines take the next unclaimed block until none are left or the
+	// context is cancelled
+	sums := make([]float64, blocks)
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	for range min(workers, blocks) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for ctx.Err() == nil {
+				block := int(next.Add(1) - 1)
+				if block >= blocks {
+					return
+				}
+				start := block * parallelBlockSize
+				end := min(start+parallelBlockSize, len(numbers))
+				sums[block] = pairwise(numbers[start:end])
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	return pairwise(sums), nil
+}
+
+// blockSums adds every block of the numbers pairwise on the calling goroutine
+func blockSums(ctx context.Context, numbers []float64) ([]float64, error) {
+	sums := make([]float64, 0, (len(numbers)+parallelBlockSize-1)/parallelBlockSize)
+	for start := 0; start < len(numbers); start += parallelBlockSize {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		end := min(start+parallelBlockSize, len(numbers))
+		sums = append(sums, pairwise(numbers[start:end]))
+	}
+	return sums, nil
+}
+
+// Blocks adds numbers that arrive one at a time in the same fixed-size blocks
+// as Parallel, so its sum is bit-for-bit the sum Parallel returns for the same
+// numbers in the same order. It buffers at most one block.
+type Blocks struct {
+	block []float64
+	sums  []float64
+}
+
+// Add adds the number to the sum
+func (b *Blocks) Add(num float64) {
+	if b.block == nil {
+		b.block = make([]float64, 0, parallelBlockSize)
+	}
+	b.block = append(b.block, num)
+	if len(b.block) == parallelBlockSize {
+		b.sums = append(b.sums, pairwise(b.block))
+		b.block = b.block[:0]
+	}
+}
+
+// Sum returns the sum of the numbers added so far
+func (b *Blocks) Sum() float64 {
+	if len(b.block) == 0 {
+		return pairwise(b.sums)
+	}
+	return pairwise(append(b.sums[:len(b.sums):len(b.sums)], pairwise(b.block)))
+}
@@ -0,0 +1,119 @@
+// Package units provides a registry of measurement units and conversions
+// between units of the same dimension.
+package units
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Dimension is the physical quantity a unit measures
+type Dimension string
+
+const (
+	Length Dimension = "length"
+	Time   Dimension = "time"
+	Mass   Dimension = "mass"
+)
+
+// Unit is a measurement unit expressed as a multiple of its dimension's base
+// unit
+type Unit struct {
+	Symbol    string
+	Dimension Dimension
+	// Factor converts a value in this unit into the base unit
+	Factor float64
+}
+
+// UnknownUnitError is returned for symbols that are not registered
+type UnknownUnitError struct {
+	Symbol string
+}
+
+func (e *UnknownUnitError) Error() string {
+	return fmt.Sprintf("unknown unit %q", e.Symbol)
+}
+
+// IncompatibleUnitsError is returned when converting between dimensions
+type IncompatibleUnitsError struct {
+	From, To Unit
+}
+
+func (e *IncompatibleUnitsError) Error() string {
+	return fmt.Sprintf("cannot convert %s (%s) to %s (%s)", e.From.Symbol, e.From.Dimension, e.To.Symbol, e.To.Dimension)
+}
+
+// Registry holds the known units by symbol
+type Registry struct {
+	mu    sync.RWMutex
+	units map[string]Unit
+}
+
+// NewRegistry creates a registry with the default length, time and mass units
+func NewRegistry() *Registry {
+	r := &Registry{units: make(map[string]Unit)}
+	for _, unit := range defaultUnits {
+		r.Register(unit)
+	}
+	return r
+}
+
+// Register adds or replaces a unit
+func (r *Registry) Register(unit Unit) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.units[unit.Symbol] = unit
+}
+
+// Lookup returns the unit registered under symbol
+func (r *Registry) Lookup(symbol string) (Unit, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	unit, ok := r.units[symbol]
+	if !ok {
+		return Unit{}, &UnknownUnitError{Symbol: symbol}
+	}
+	return unit, nil
+}
+
+// Convert converts value from one unit into another of the same dimension
+func Convert(value float64, from, to Unit) (float64, error) {
+	if from.Dimension != to.Dimension {
+		return 0, &IncompatibleUnitsError{From: from, To: to}
+	}
+	if from.Symbol == to.Symbol {
+		return value, nil
+	}
+	return value * from.Factor / to.Factor, nil
+}
+
+var defaultUnits = []Unit{
+	// Length, base unit metre
+	{Symbol: "nm", Dimension: Length, Factor: 1e-9},
+	{Symbol: "um", Dimension: Length, Factor: 1e-6},
+	{Symbol: "mm", Dimension: Length, Factor: 1e-3},
+	{Symbol: "cm", Dimension: Length, Factor: 1e-2},
+	{Symbol: "m", Dimension: Length, Factor: 1},
+	{Symbol: "km", Dimension: Length, Factor: 1e3},
+	{Symbol: "in", Dimension: Length, Factor: 0.0254},
+	{Symbol: "ft", Dimension: Length, Factor: 0.3048},
+	{Symbol: "yd", Dimension: Length, Factor: 0.9144},
+	{Symbol: "mi", Dimension: Length, Factor: 1609.344},
+
+	// Time, base unit second
+	{Symbol: "ns", Dimension: Time, Factor: 1e-9},
+	{Symbol: "us", Dimension: Time, Factor: 1e-6},
+	{Symbol: "ms", Dimension: Time, Factor: 1e-3},
+	{Symbol: "s", Dimension: Time, Factor: 1},
+	{Symbol: "min", Dimension: Time, Factor: 60},
+	{Symbol: "h", Dimension: Time, Factor: 3600},
+	{Symbol: "d", Dimension: Time, Factor: 86400},
+
+	// Mass, base unit kilogram
+	{Symbol: "mg", Dimension: Mass, Factor: 1e-6},
+	{Symbol: "g", Dimension: Mass, Factor: 1e-3},
+	{Symbol: "kg", Dimension: Mass, Factor: 1},
+	{Symbol: "t", Dimension: Mass, Factor: 1e3},
+	{Symbol: "oz", Dimension: Mass, Factor: 0.028349523125},
+	{Symbol: "lb", Dimension: Mass, Factor: 0.45359237},
+}
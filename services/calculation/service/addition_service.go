@@ -3,6 +3,9 @@ package service
 import (
 	"context"
 
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+
 	"github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
 	internalService "github.com/yourusername/proto-buf-experiment/services/calculation/internal/service"
 )
@@ -20,7 +23,121 @@ func NewAdditionService() *AdditionService {
 	}
 }
 
+// UnaryServerInterceptor attaches the response of a failed call to the gRPC
+// status, so that clients receive the ErrorInfo describing the failure
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return internalService.UnaryServerInterceptor()
+}
+
+// LoadExchangeRates loads the exchange rates used to convert money operands
+// from a JSON file
+func (s *AdditionService) LoadExchangeRates(path string) error {
+	return s.internalService.LoadExchangeRates(path)
+}
+
+// UseLogger sets the logger reporting calculations that could not be
+// recorded in the history
+func (s *AdditionService) UseLogger(logger zerolog.Logger) {
+	s.internalService.UseLogger(logger)
+}
+
+// OpenHistoryFile records calculations in the history file at path, keeping
+// the calculations already recorded in it
+func (s *AdditionService) OpenHistoryFile(path string) error {
+	return s.internalService.OpenHistoryFile(path)
+}
+
+// Close releases the resources of the service, such as the history file
+func (s *AdditionService) Close() error {
+	return s.internalService.Close()
+}
+
 // Add delegates the addition operation to the internal service
 func (s *AdditionService) Add(ctx context.Context, req *v1.AddRequest) (*v1.AddResponse, error) {
 	return s.internalService.Add(ctx, req)
 }
+
+// Subtract delegates the subtraction operation to the internal service
+func (s *AdditionService) Subtract(ctx context.Context, req *v1.SubtractRequest) (*v1.SubtractResponse, error) {
+	return s.internalService.Subtract(ctx, req)
+}
+
+// Multiply delegates the multiplication operation to the internal service
+func (s *AdditionService) Multiply(ctx context.Context, req *v1.MultiplyRequest) (*v1.MultiplyResponse, error) {
+	return s.internalService.Multiply(ctx, req)
+}
+
+// Divide delegates the division operation to the internal service
+func (s *AdditionService) Divide(ctx context.Context, req *v1.DivideRequest) (*v1.DivideResponse, error) {
+	return s.internalService.Divide(ctx, req)
+}
+
+// Evaluate delegates the expression evaluation to the internal service
+func (s *AdditionService) Evaluate(ctx context.Context, req *v1.EvaluateRequest) (*v1.EvaluateResponse, error) {
+	return s.internalService.Evaluate(ctx, req)
+}
+
+// AddStream delegates the streaming addition to the internal service
+func (s *AdditionService) AddStream(stream v1.AdditionService_AddStreamServer) error {
+	return s.internalService.AddStream(stream)
+}
+
+// AddProgress delegates the progress-reporting addition to the internal service
+func (s *AdditionService) AddProgress(req *v1.AddProgressRequest, stream v1.AdditionService_AddProgressServer) error {
+	return s.internalService.AddProgress(req, stream)
+}
+
+// Session delegates the interactive calculator session to the internal service
+func (s *AdditionService) Session(stream v1.AdditionService_SessionServer) error {
+	return s.internalService.Session(stream)
+}
+
+// Describe delegates the descriptive statistics to the internal service
+func (s *AdditionService) Describe(ctx context.Context, req *v1.DescribeRequest) (*v1.DescribeResponse, error) {
+	return s.internalService.Describe(ctx, req)
+}
+
+// BatchAdd delegates the batch addition to the internal service
+func (s *AdditionService) BatchAdd(ctx context.Context, req *v1.BatchAddRequest) (*v1.BatchAddResponse, error) {
+	return s.internalService.BatchAdd(ctx, req)
+}
+
+// AddVectors delegates the element-wise vector addition to the internal service
+func (s *AdditionService) AddVectors(ctx context.Context, req *v1.AddVectorsRequest) (*v1.AddVectorsResponse, error) {
+	return s.internalService.AddVectors(ctx, req)
+}
+
+// AddMatrices delegates the element-wise matrix addition to the internal service
+func (s *AdditionService) AddMatrices(ctx context.Context, req *v1.AddMatricesRequest) (*v1.AddMatricesResponse, error) {
+	return s.internalService.AddMatrices(ctx, req)
+}
+
+// ListCalculations delegates paging through the calculation history to the internal service
+func (s *AdditionService) ListCalculations(ctx context.Context, req *v1.ListCalculationsRequest) (*v1.ListCalculationsResponse, error) {
+	return s.internalService.ListCalculations(ctx, req)
+}
+
+// GetCalculation delegates looking up a past calculation to the internal service
+func (s *AdditionService) GetCalculation(ctx context.Context, req *v1.GetCalculationRequest) (*v1.GetCalculationResponse, error) {
+	return s.internalService.GetCalculation(ctx, req)
+}
+
+// SubmitCalculation delegates queueing a background calculation to the internal service
+func (s *AdditionService) SubmitCalculation(ctx context.Context, req *v1.SubmitCalculationRequest) (*v1.SubmitCalculationResponse, error) {
+	return s.internalService.SubmitCalculation(ctx, req)
+}
+
+// GetJob delegates looking up a background calculation to the internal service
+func (s *AdditionService) GetJob(ctx context.Context, req *v1.GetJobRequest) (*v1.GetJobResponse, error) {
+	return s.internalService.GetJob(ctx, req)
+}
+
+// CancelJob delegates cancelling a background calculation to the internal service
+func (s *AdditionService) CancelJob(ctx context.Context, req *v1.CancelJobRequest) (*v1.CancelJobResponse, error) {
+	return s.internalService.CancelJob(ctx, req)
+}
+
+// WaitJob delegates waiting for a background calculation to the internal service
+func (s *AdditionService) WaitJob(ctx context.Context, req *v1.WaitJobRequest) (*v1.WaitJobResponse, error) {
+	return s.internalService.WaitJob(ctx, req)
+}
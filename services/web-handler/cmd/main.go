@@ -40,6 +40,14 @@ func main() {
 
 	// Setup routes
 	http.HandleFunc("/add", handler.AddHandler)
+	http.HandleFunc("/add/batch", handler.BatchAddHandler)
+	http.HandleFunc("/subtract", handler.SubtractHandler)
+	http.HandleFunc("/multiply", handler.MultiplyHandler)
+	http.HandleFunc("/divide", handler.DivideHandler)
+	http.HandleFunc("/evaluate", handler.EvaluateHandler)
+	http.HandleFunc("/describe", handler.DescribeHandler)
+	http.HandleFunc("GET /calculations", handler.ListCalculationsHandler)
+	http.HandleFunc("GET /calculations/{request_id}", handler.GetCalculationHandler)
 
 	// Log server start
 	logger.Info().
@@ -0,0 +1,92 @@
+package webhandler
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// Float is a float64 whose JSON form can also hold NaN and infinities, as the
+// strings "NaN", "Infinity" and "-Infinity"
+type Float float64
+
+// MarshalJSON encodes finite values as JSON numbers and others as strings
+func (f Float) MarshalJSON() ([]byte, error) {
+	switch v := float64(f); {
+	case math.IsNaN(v):
+		return []byte(`"NaN"`), nil
+	case math.IsInf(v, 1):
+		return []byte(`"Infinity"`), nil
+	case math.IsInf(v, -1):
+		return []byte(`"-Infinity"`), nil
+	default:
+		return json.Marshal(v)
+	}
+}
+
+// UnmarshalJSON decodes a JSON number or one of the non-finite strings
+func (f *Float) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		switch text {
+		case "NaN":
+			*f = Float(math.NaN())
+		case "Infinity", "+Infinity":
+			*f = Float(math.Inf(1))
+		case "-Infinity":
+			*f = Float(math.Inf(-1))
+		default:
+			return fmt.Errorf("invalid number %q", text)
+		}
+		return nil
+	}
+
+	var v float64
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*f = Float(v)
+	return nil
+}
+
+// Floats is a list of numbers encoded like Float
+type Floats []float64
+
+// MarshalJSON encodes the numbers as a JSON array of Float values
+func (f Floats) MarshalJSON() ([]byte, error) {
+	if f == nil {
+		return []byte("null"), nil
+	}
+	values := make([]Float, len(f))
+	for i, v := range f {
+		values[i] = Float(v)
+	}
+	return json.Marshal(values)
+}
+
+// UnmarshalJSON decodes a JSON array of Float values
+func (f *Floats) UnmarshalJSON(data []byte) error {
+	var values []Float
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	if values == nil {
+		*f = nil
+		return nil
+	}
+	numbers := make(Floats, len(values))
+	for i, v := range values {
+		numbers[i] = float64(v)
+	}
+	*f = numbers
+	return nil
+}
+
+// MarshalJSON encodes the response, writing a non-finite result as a string
+func (r AddResponse) MarshalJSON() ([]byte, error) {
+	type plain AddResponse
+	return json.Marshal(struct {
+		plain
+		Result Float `json:"result"`
+	}{plain: plain(r), Result: Float(r.Result)})
+}
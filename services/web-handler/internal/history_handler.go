@@ -0,0 +1,206 @@
+package webhandler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1 "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+)
+
+type Calculation struct {
+	RequestID         string          `json:"request_id"`
+	Operation         string          `json:"operation"`
+	Request           json.RawMessage `json:"request,omitempty"`
+	Response          json.RawMessage `json:"response,omitempty"`
+	ErrorCode         string          `json:"error_code,omitempty"`
+	CalculationMethod string          `json:"calculation_method,omitempty"`
+	CalculationTime   string          `json:"calculation_time"`
+	DurationMs        float64         `json:"duration_ms"`
+	NumbersProcessed  int32           `json:"numbers_processed,omitempty"`
+	Truncated         bool            `json:"truncated,omitempty"`
+}
+
+type ListCalculationsResponse struct {
+	Calculations  []Calculation `json:"calculations"`
+	NextPageToken string        `json:"next_page_token,omitempty"`
+	Error         *ErrorInfo    `json:"error,omitempty"`
+}
+
+type GetCalculationResponse struct {
+	Calculation *Calculation `json:"calculation,omitempty"`
+	Error       *ErrorInfo   `json:"error,omitempty"`
+}
+
+// ListCalculationsHandler pages through past calculations. The filters and
+// page are read from the query parameters start_time and end_time (RFC 3339),
+// error_code, calculation_method, request_id_prefix, page_size and page_token.
+func (h *WebHandler) ListCalculationsHandler(w http.ResponseWriter, r *http.Request) {
+	// Set content type to JSON
+	w.Header().Set("Content-Type", "application/json")
+
+	// Parse query parameters
+	req, err := listCalculationsRequest(r)
+	if err != nil {
+		h.logger.Error().
+			Err(err).
+			Msg("Failed to parse query parameters")
+
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(ListCalculationsResponse{
+			Error: &ErrorInfo{
+				Code:     "BAD_REQUEST",
+				Message:  err.Error(),
+				Severity: "ERROR",
+			},
+		})
+		return
+	}
+
+	response, err := fromStatus(h.calculationClient.ListCalculations(context.Background(), req))
+	if err != nil || response.GetError() != nil {
+		errorInfo := h.historyError("list_calculations", response, err)
+		w.WriteHeader(historyStatus(errorInfo.Code))
+		json.NewEncoder(w).Encode(ListCalculationsResponse{Error: errorInfo})
+		return
+	}
+
+	httpResponse := ListCalculationsResponse{
+		Calculations:  make([]Calculation, len(response.Calculations)),
+		NextPageToken: response.NextPageToken,
+	}
+	for i, calculation := range response.Calculations {
+		httpResponse.Calculations[i] = toCalculation(calculation)
+	}
+
+	// Send response
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(httpResponse)
+}
+
+// GetCalculationHandler looks up the past calculation named by the
+// request_id path value
+func (h *WebHandler) GetCalculationHandler(w http.ResponseWriter, r *http.Request) {
+	// Set content type to JSON
+	w.Header().Set("Content-Type", "application/json")
+
+	response, err := fromStatus(h.calculationClient.GetCalculation(context.Background(), &v1.GetCalculationRequest{
+		RequestId: r.PathValue("request_id"),
+	}))
+	if err != nil || response.GetError() != nil {
+		errorInfo := h.historyError("get_calculation", response, err)
+		w.WriteHeader(historyStatus(errorInfo.Code))
+		json.NewEncoder(w).Encode(GetCalculationResponse{Error: errorInfo})
+		return
+	}
+
+	calculation := toCalculation(response.Calculation)
+
+	// Send response
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(GetCalculationResponse{Calculation: &calculation})
+}
+
+// listCalculationsRequest builds the gRPC request from the query parameters
+func listCalculationsRequest(r *http.Request) (*v1.ListCalculationsRequest, error) {
+	query := r.URL.Query()
+	req := &v1.ListCalculationsRequest{
+		ErrorCode:         query.Get("error_code"),
+		CalculationMethod: query.Get("calculation_method"),
+		RequestIdPrefix:   query.Get("request_id_prefix"),
+		PageToken:         query.Get("page_token"),
+	}
+
+	var err error
+	if req.StartTime, err = queryTime(r, "start_time"); err != nil {
+		return nil, err
+	}
+	if req.EndTime, err = queryTime(r, "end_time"); err != nil {
+		return nil, err
+	}
+
+	if value := query.Get("page_size"); value != "" {
+		size, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid page_size %q", value)
+		}
+		req.PageSize = int32(size)
+	}
+
+	return req, nil
+}
+
+// queryTime parses an optional RFC 3339 time query parameter
+func queryTime(r *http.Request, name string) (*timestamppb.Timestamp, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s %q, expected an RFC 3339 time", name, value)
+	}
+	return timestamppb.New(t), nil
+}
+
+// historyResponse is implemented by the responses of history lookups
+type historyResponse interface {
+	GetError() *v1.AddResponse_ErrorInfo
+}
+
+// historyError logs and converts a failed history lookup
+func (h *WebHandler) historyError(operation string, response historyResponse, err error) *ErrorInfo {
+	errorInfo := &ErrorInfo{
+		Code:     "GRPC_ERROR",
+		Severity: "ERROR",
+	}
+	if response.GetError() != nil {
+		errorInfo = toErrorInfo(response.GetError())
+	} else if err != nil {
+		errorInfo.Message = err.Error()
+	}
+
+	h.logger.Error().
+		Str("operation", operation).
+		Str("error_code", errorInfo.Code).
+		Str("error_message", errorInfo.Message).
+		Msg("History lookup failed")
+	return errorInfo
+}
+
+// historyStatus maps the error code of a history lookup to its HTTP status
+func historyStatus(code string) int {
+	switch code {
+	case "CALCULATION_NOT_FOUND":
+		return http.StatusNotFound
+	case "INVALID_PAGE_SIZE", "INVALID_PAGE_TOKEN":
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+// toCalculation converts a past calculation into its JSON form
+func toCalculation(calculation *v1.Calculation) Calculation {
+	result := Calculation{
+		RequestID:         calculation.RequestId,
+		Operation:         calculation.Operation,
+		ErrorCode:         calculation.ErrorCode,
+		CalculationMethod: calculation.CalculationMethod,
+		CalculationTime:   calculation.CalculationTime.AsTime().Format(time.RFC3339Nano),
+		DurationMs:        float64(calculation.Duration.AsDuration()) / float64(time.Millisecond),
+		NumbersProcessed:  calculation.NumbersProcessed,
+		Truncated:         calculation.Truncated,
+	}
+	if calculation.Request != "" {
+		result.Request = json.RawMessage(calculation.Request)
+	}
+	if calculation.Response != "" {
+		result.Response = json.RawMessage(calculation.Response)
+	}
+	return result
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/rs/zerolog"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
 
 	v1 "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
 	"github.com/yourusername/proto-buf-experiment/pkg/logging"
@@ -18,29 +21,159 @@ type WebHandler struct {
 }
 
 type AddRequest struct {
-	Numbers    []float64 `json:"numbers"`
-	MinValue   *float64  `json:"min_value,omitempty"`
-	MaxValue   *float64  `json:"max_value,omitempty"`
-	MaxNumbers *int32    `json:"max_numbers,omitempty"`
+	RequestID        string             `json:"request_id,omitempty"`
+	Numbers          Floats             `json:"numbers"`
+	MinValue         *float64           `json:"min_value,omitempty"`
+	MaxValue         *float64           `json:"max_value,omitempty"`
+	MaxNumbers       *int32             `json:"max_numbers,omitempty"`
+	MinSum           *float64           `json:"min_sum,omitempty"`
+	MaxSum           *float64           `json:"max_sum,omitempty"`
+	MinNumbers       *int32             `json:"min_numbers,omitempty"`
+	IntegerOnly      bool               `json:"integer_only,omitempty"`
+	MaxDecimalPlaces *int32             `json:"max_decimal_places,omitempty"`
+	FiniteOnly       bool               `json:"finite_only,omitempty"`
+	NonNegative      bool               `json:"non_negative,omitempty"`
+	Unique           bool               `json:"unique,omitempty"`
+	Actions          *ConstraintActions `json:"actions,omitempty"`
+	NaNPolicy        string             `json:"nan_policy,omitempty"`
+	InfinityPolicy   string             `json:"infinity_policy,omitempty"`
+	ValidationMode   string             `json:"validation_mode,omitempty"`
+	Units            []string           `json:"units,omitempty"`
+	ResultUnit       *string            `json:"result_unit,omitempty"`
+	Money            []Money            `json:"money,omitempty"`
+	MoneyOptions     *MoneyOptions      `json:"money_options,omitempty"`
+	Fractions        []Fraction         `json:"fractions,omitempty"`
+	Complex          []Complex          `json:"complex,omitempty"`
+	Intervals        []Interval         `json:"intervals,omitempty"`
 }
 
 type AddResponse struct {
 	Result              float64       `json:"result"`
+	Unit                string        `json:"unit,omitempty"`
+	MoneyResult         *Money        `json:"money_result,omitempty"`
+	FractionResult      *Fraction     `json:"fraction_result,omitempty"`
+	ComplexResult       *Complex      `json:"complex_result,omitempty"`
+	IntervalResult      *Interval     `json:"interval_result,omitempty"`
 	Error               *ErrorInfo    `json:"error,omitempty"`
 	RequestID           string        `json:"request_id"`
 	CalculationMetadata *CalcMetadata `json:"calculation_metadata,omitempty"`
 }
 
+type Money struct {
+	Currency string `json:"currency"`
+	Amount   string `json:"amount"`
+}
+
+type ConstraintActions struct {
+	MinValue         string `json:"min_value,omitempty"`
+	MaxValue         string `json:"max_value,omitempty"`
+	MaxNumbers       string `json:"max_numbers,omitempty"`
+	IntegerOnly      string `json:"integer_only,omitempty"`
+	MaxDecimalPlaces string `json:"max_decimal_places,omitempty"`
+	NonNegative      string `json:"non_negative,omitempty"`
+	Unique           string `json:"unique,omitempty"`
+	FiniteOnly       string `json:"finite_only,omitempty"`
+	MinSum           string `json:"min_sum,omitempty"`
+	MaxSum           string `json:"max_sum,omitempty"`
+	MinNumbers       string `json:"min_numbers,omitempty"`
+}
+
+type MoneyOptions struct {
+	Currency          *string `json:"currency,omitempty"`
+	ConvertCurrencies bool    `json:"convert_currencies,omitempty"`
+	RoundingMode      string  `json:"rounding_mode,omitempty"`
+}
+
+type Fraction struct {
+	Numerator   string `json:"numerator"`
+	Denominator string `json:"denominator,omitempty"`
+}
+
+type Complex struct {
+	Re Float `json:"re"`
+	Im Float `json:"im"`
+}
+
+type Interval struct {
+	Lo          float64  `json:"lo"`
+	Hi          float64  `json:"hi"`
+	Value       *float64 `json:"value,omitempty"`
+	Uncertainty float64  `json:"uncertainty,omitempty"`
+}
+
 type ErrorInfo struct {
-	Code     string `json:"code"`
-	Message  string `json:"message"`
-	Severity string `json:"severity"`
+	Code       string      `json:"code"`
+	Message    string      `json:"message"`
+	Severity   string      `json:"severity"`
+	Position   *int32      `json:"position,omitempty"`
+	Violations []Violation `json:"violations,omitempty"`
+}
+
+type Violation struct {
+	Index   int32  `json:"index"`
+	Value   Float  `json:"value"`
+	Rule    string `json:"rule"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type BatchAddRequest struct {
+	Requests []AddRequest `json:"requests"`
+}
+
+type BatchAddResponse struct {
+	Responses   []AddResponse `json:"responses"`
+	FailedCount int32         `json:"failed_count"`
+	Error       *ErrorInfo    `json:"error,omitempty"`
+	RequestID   string        `json:"request_id"`
+}
+
+type EvaluateRequest struct {
+	Expression string `json:"expression"`
+}
+
+type EvaluateResponse struct {
+	Result              float64       `json:"result"`
+	Error               *ErrorInfo    `json:"error,omitempty"`
+	ParseErrors         []ErrorInfo   `json:"parse_errors,omitempty"`
+	RequestID           string        `json:"request_id"`
+	CalculationMetadata *CalcMetadata `json:"calculation_metadata,omitempty"`
+}
+
+type DescribeRequest struct {
+	AddRequest
+	Percentiles []float64 `json:"percentiles,omitempty"`
+}
+
+type DescribeResponse struct {
+	Sum                     Float         `json:"sum"`
+	Mean                    Float         `json:"mean"`
+	Variance                Float         `json:"variance"`
+	StandardDeviation       Float         `json:"standard_deviation"`
+	SampleVariance          Float         `json:"sample_variance"`
+	SampleStandardDeviation Float         `json:"sample_standard_deviation"`
+	Min                     Float         `json:"min"`
+	Max                     Float         `json:"max"`
+	Median                  Float         `json:"median"`
+	Percentiles             []Percentile  `json:"percentiles,omitempty"`
+	Error                   *ErrorInfo    `json:"error,omitempty"`
+	RequestID               string        `json:"request_id"`
+	CalculationMetadata     *CalcMetadata `json:"calculation_metadata,omitempty"`
+}
+
+type Percentile struct {
+	Percentile float64 `json:"percentile"`
+	Value      Float   `json:"value"`
 }
 
 type CalcMetadata struct {
-	CalculationTime   string `json:"calculation_time"`
-	NumbersProcessed  int32  `json:"numbers_processed"`
-	CalculationMethod string `json:"calculation_method"`
+	CalculationTime   string      `json:"calculation_time"`
+	NumbersProcessed  int32       `json:"numbers_processed"`
+	CalculationMethod string      `json:"calculation_method"`
+	RoundingMode      string      `json:"rounding_mode,omitempty"`
+	ClampedCount      int32       `json:"clamped_count,omitempty"`
+	DroppedCount      int32       `json:"dropped_count,omitempty"`
+	Warnings          []ErrorInfo `json:"warnings,omitempty"`
 }
 
 func NewWebHandler(
@@ -53,7 +186,243 @@ func NewWebHandler(
 	}
 }
 
+// constraints builds the optional validation parameters of the request
+func (r AddRequest) constraints() *v1.AddRequest_Constraints {
+	if r.MinValue == nil && r.MaxValue == nil && r.MaxNumbers == nil &&
+		r.NaNPolicy == "" && r.InfinityPolicy == "" && r.ValidationMode == "" &&
+		r.MinSum == nil && r.MaxSum == nil && r.MinNumbers == nil && r.MaxDecimalPlaces == nil &&
+		!r.IntegerOnly && !r.FiniteOnly && !r.NonNegative && !r.Unique && r.Actions == nil {
+		return nil
+	}
+	c := &v1.AddRequest_Constraints{
+		MinValue:         r.MinValue,
+		MaxValue:         r.MaxValue,
+		MaxNumbers:       r.MaxNumbers,
+		NanPolicy:        nonFinitePolicy(r.NaNPolicy),
+		InfinityPolicy:   nonFinitePolicy(r.InfinityPolicy),
+		ValidationMode:   validationMode(r.ValidationMode),
+		MinSum:           r.MinSum,
+		MaxSum:           r.MaxSum,
+		IntegerOnly:      r.IntegerOnly,
+		MaxDecimalPlaces: r.MaxDecimalPlaces,
+		FiniteOnly:       r.FiniteOnly,
+		NonNegative:      r.NonNegative,
+		MinNumbers:       r.MinNumbers,
+		Unique:           r.Unique,
+	}
+	if a := r.Actions; a != nil {
+		c.MinValueAction = constraintAction(a.MinValue)
+		c.MaxValueAction = constraintAction(a.MaxValue)
+		c.MaxNumbersAction = constraintAction(a.MaxNumbers)
+		c.IntegerOnlyAction = constraintAction(a.IntegerOnly)
+		c.MaxDecimalPlacesAction = constraintAction(a.MaxDecimalPlaces)
+		c.NonNegativeAction = constraintAction(a.NonNegative)
+		c.UniqueAction = constraintAction(a.Unique)
+		c.FiniteOnlyAction = constraintAction(a.FiniteOnly)
+		c.MinSumAction = constraintAction(a.MinSum)
+		c.MaxSumAction = constraintAction(a.MaxSum)
+		c.MinNumbersAction = constraintAction(a.MinNumbers)
+	}
+	return c
+}
+
+// constraintAction resolves an action name such as "clamp". Unknown names map
+// to an invalid action so that the calculation service rejects them.
+func constraintAction(name string) v1.ConstraintAction {
+	if name == "" {
+		return v1.ConstraintAction_CONSTRAINT_ACTION_UNSPECIFIED
+	}
+	action, ok := v1.ConstraintAction_value["CONSTRAINT_ACTION_"+strings.ToUpper(name)]
+	if !ok {
+		return -1
+	}
+	return v1.ConstraintAction(action)
+}
+
+// validationMode resolves a validation mode name such as "collect_all".
+// Unknown names fall back to failing fast.
+func validationMode(name string) v1.ValidationMode {
+	mode := v1.ValidationMode_value["VALIDATION_MODE_"+strings.ToUpper(name)]
+	return v1.ValidationMode(mode)
+}
+
+// nonFinitePolicy resolves a policy name such as "skip". Unknown names map to
+// an invalid policy so that the calculation service rejects them.
+func nonFinitePolicy(name string) v1.NonFinitePolicy {
+	if name == "" {
+		return v1.NonFinitePolicy_NON_FINITE_POLICY_UNSPECIFIED
+	}
+	policy, ok := v1.NonFinitePolicy_value["NON_FINITE_POLICY_"+strings.ToUpper(name)]
+	if !ok {
+		return -1
+	}
+	return v1.NonFinitePolicy(policy)
+}
+
+// toProto converts the request into its gRPC form
+func (r AddRequest) toProto() *v1.AddRequest {
+	return &v1.AddRequest{
+		RequestId:       r.RequestID,
+		Numbers:         r.Numbers,
+		Constraints:     r.constraints(),
+		Units:           r.Units,
+		ResultUnit:      r.ResultUnit,
+		MoneyNumbers:    toProtoMoney(r.Money),
+		MoneyOptions:    r.MoneyOptions.toProto(),
+		FractionNumbers: toProtoFractions(r.Fractions),
+		ComplexNumbers:  toProtoComplex(r.Complex),
+		IntervalNumbers: toProtoIntervals(r.Intervals),
+	}
+}
+
+// toProtoMoney converts monetary amounts into their gRPC form
+func toProtoMoney(amounts []Money) []*v1.Money {
+	if len(amounts) == 0 {
+		return nil
+	}
+	money := make([]*v1.Money, len(amounts))
+	for i, amount := range amounts {
+		money[i] = &v1.Money{Currency: amount.Currency, Amount: amount.Amount}
+	}
+	return money
+}
+
+// toProtoFractions converts fractions into their gRPC form
+func toProtoFractions(fractions []Fraction) []*v1.Fraction {
+	if len(fractions) == 0 {
+		return nil
+	}
+	protoFractions := make([]*v1.Fraction, len(fractions))
+	for i, fraction := range fractions {
+		protoFractions[i] = &v1.Fraction{Numerator: fraction.Numerator, Denominator: fraction.Denominator}
+	}
+	return protoFractions
+}
+
+// toProtoComplex converts complex numbers into their gRPC form
+func toProtoComplex(numbers []Complex) []*v1.Complex {
+	if len(numbers) == 0 {
+		return nil
+	}
+	protoNumbers := make([]*v1.Complex, len(numbers))
+	for i, num := range numbers {
+		protoNumbers[i] = &v1.Complex{Re: float64(num.Re), Im: float64(num.Im)}
+	}
+	return protoNumbers
+}
+
+// toProtoIntervals converts intervals into their gRPC form
+func toProtoIntervals(intervals []Interval) []*v1.Interval {
+	if len(intervals) == 0 {
+		return nil
+	}
+	protoIntervals := make([]*v1.Interval, len(intervals))
+	for i, iv := range intervals {
+		protoIntervals[i] = &v1.Interval{Lo: iv.Lo, Hi: iv.Hi, Value: iv.Value, Uncertainty: iv.Uncertainty}
+	}
+	return protoIntervals
+}
+
+// toProto converts the money options into their gRPC form
+func (o *MoneyOptions) toProto() *v1.AddRequest_MoneyOptions {
+	if o == nil {
+		return nil
+	}
+	return &v1.AddRequest_MoneyOptions{
+		Currency:          o.Currency,
+		ConvertCurrencies: o.ConvertCurrencies,
+		RoundingMode:      roundingMode(o.RoundingMode),
+	}
+}
+
+// roundingMode resolves a rounding mode name such as "half_up". Unknown names
+// map to an invalid mode so that the calculation service rejects them.
+func roundingMode(name string) v1.RoundingMode {
+	if name == "" {
+		return v1.RoundingMode_ROUNDING_MODE_UNSPECIFIED
+	}
+	mode, ok := v1.RoundingMode_value["ROUNDING_MODE_"+strings.ToUpper(name)]
+	if !ok {
+		return -1
+	}
+	return v1.RoundingMode(mode)
+}
+
+// roundingModeName is the inverse of roundingMode
+func roundingModeName(mode v1.RoundingMode) string {
+	if mode == v1.RoundingMode_ROUNDING_MODE_UNSPECIFIED {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(mode.String(), "ROUNDING_MODE_"))
+}
+
+// calculationResponse is the shape shared by every arithmetic RPC response
+type calculationResponse interface {
+	GetResult() float64
+	GetError() *v1.AddResponse_ErrorInfo
+	GetRequestId() string
+	GetCalculationMetadata() *v1.AddResponse_CalculationMetadata
+}
+
+// fromStatus recovers the response a failed call carries in its gRPC status.
+// The calculation service attaches it so that the ErrorInfo describing the
+// failure reaches the client; errors without one are returned unchanged.
+func fromStatus[T protoadapt.MessageV1](response T, err error) (T, error) {
+	if err == nil {
+		return response, nil
+	}
+	for _, detail := range status.Convert(err).Details() {
+		if detailed, ok := detail.(T); ok {
+			return detailed, nil
+		}
+	}
+	return response, err
+}
+
 func (h *WebHandler) AddHandler(w http.ResponseWriter, r *http.Request) {
+	h.handleArithmetic(w, r, "add", func(ctx context.Context, req AddRequest) (calculationResponse, error) {
+		return fromStatus(h.calculationClient.Add(ctx, req.toProto()))
+	})
+}
+
+func (h *WebHandler) SubtractHandler(w http.ResponseWriter, r *http.Request) {
+	h.handleArithmetic(w, r, "subtract", func(ctx context.Context, req AddRequest) (calculationResponse, error) {
+		return fromStatus(h.calculationClient.Subtract(ctx, &v1.SubtractRequest{
+			RequestId:   req.RequestID,
+			Numbers:     req.Numbers,
+			Constraints: req.constraints(),
+		}))
+	})
+}
+
+func (h *WebHandler) MultiplyHandler(w http.ResponseWriter, r *http.Request) {
+	h.handleArithmetic(w, r, "multiply", func(ctx context.Context, req AddRequest) (calculationResponse, error) {
+		return fromStatus(h.calculationClient.Multiply(ctx, &v1.MultiplyRequest{
+			RequestId:   req.RequestID,
+			Numbers:     req.Numbers,
+			Constraints: req.constraints(),
+		}))
+	})
+}
+
+func (h *WebHandler) DivideHandler(w http.ResponseWriter, r *http.Request) {
+	h.handleArithmetic(w, r, "divide", func(ctx context.Context, req AddRequest) (calculationResponse, error) {
+		return fromStatus(h.calculationClient.Divide(ctx, &v1.DivideRequest{
+			RequestId:   req.RequestID,
+			Numbers:     req.Numbers,
+			Constraints: req.constraints(),
+		}))
+	})
+}
+
+// handleArithmetic decodes an AddRequest body, performs the calculation and
+// writes the JSON response
+func (h *WebHandler) handleArithmetic(
+	w http.ResponseWriter,
+	r *http.Request,
+	operation string,
+	calculate func(ctx context.Context, req AddRequest) (calculationResponse, error),
+) {
 	// Set content type to JSON
 	w.Header().Set("Content-Type", "application/json")
 
@@ -77,35 +446,22 @@ func (h *WebHandler) AddHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Prepare gRPC request
-	grpcRequest := &v1.AddRequest{
-		Numbers: addRequest.Numbers,
-	}
-
-	// Optional: add validation parameters
-	if addRequest.MinValue != nil || addRequest.MaxValue != nil || addRequest.MaxNumbers != nil {
-		grpcRequest.Constraints = &v1.AddRequest_Constraints{
-			MinValue:   addRequest.MinValue,
-			MaxValue:   addRequest.MaxValue,
-			MaxNumbers: addRequest.MaxNumbers,
-		}
-	}
-
 	// Perform calculation
 	start := time.Now()
-	response, err := h.calculationClient.Add(context.Background(), grpcRequest)
+	response, err := calculate(context.Background(), addRequest)
 
 	// Log calculation details
 	duration := time.Since(start)
 	logFields := map[string]interface{}{
-		"request_id":     response.RequestId,
+		"operation":      operation,
+		"request_id":     response.GetRequestId(),
 		"numbers_count":  len(addRequest.Numbers),
 		"duration_ms":    duration.Milliseconds(),
-		"calculation_ok": err == nil,
+		"calculation_ok": err == nil && response.GetError() == nil,
 	}
 
 	// Handle gRPC error or service-level error
-	if err != nil || response.Error != nil {
+	if err != nil || response.GetError() != nil {
 		var errorCode, errorMessage string
 		var severity string
 
@@ -114,9 +470,9 @@ func (h *WebHandler) AddHandler(w http.ResponseWriter, r *http.Request) {
 			errorMessage = err.Error()
 			severity = "ERROR"
 		} else {
-			errorCode = response.Error.Code
-			errorMessage = response.Error.Message
-			severity = response.Error.Severity.String()
+			errorCode = response.GetError().Code
+			errorMessage = response.GetError().Message
+			severity = response.GetError().Severity.String()
 		}
 
 		h.logger.Error().
@@ -126,11 +482,12 @@ func (h *WebHandler) AddHandler(w http.ResponseWriter, r *http.Request) {
 			Msg("Calculation failed")
 
 		httpResponse := AddResponse{
-			RequestID: response.RequestId,
+			RequestID: response.GetRequestId(),
 			Error: &ErrorInfo{
-				Code:     errorCode,
-				Message:  errorMessage,
-				Severity: severity,
+				Code:       errorCode,
+				Message:    errorMessage,
+				Severity:   severity,
+				Violations: toViolations(response.GetError().GetViolations()),
 			},
 		}
 		w.WriteHeader(http.StatusInternalServerError)
@@ -143,20 +500,386 @@ func (h *WebHandler) AddHandler(w http.ResponseWriter, r *http.Request) {
 		Fields(logFields).
 		Msg("Calculation completed successfully")
 
-	// Prepare HTTP response
+	// Send response
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(toAddResponse(response))
+}
+
+// toAddResponse converts an arithmetic RPC response into its JSON form
+func toAddResponse(response calculationResponse) AddResponse {
 	httpResponse := AddResponse{
+		Result:              response.GetResult(),
+		RequestID:           response.GetRequestId(),
+		CalculationMetadata: toCalcMetadata(response.GetCalculationMetadata()),
+	}
+	if response.GetError() != nil {
+		httpResponse.Error = toErrorInfo(response.GetError())
+	}
+	if withUnit, ok := response.(interface{ GetUnit() string }); ok {
+		httpResponse.Unit = withUnit.GetUnit()
+	}
+	if withMoney, ok := response.(interface{ GetMoneyResult() *v1.Money }); ok && withMoney.GetMoneyResult() != nil {
+		httpResponse.MoneyResult = &Money{
+			Currency: withMoney.GetMoneyResult().Currency,
+			Amount:   withMoney.GetMoneyResult().Amount,
+		}
+	}
+	if withFraction, ok := response.(interface{ GetFractionResult() *v1.Fraction }); ok && withFraction.GetFractionResult() != nil {
+		httpResponse.FractionResult = &Fraction{
+			Numerator:   withFraction.GetFractionResult().Numerator,
+			Denominator: withFraction.GetFractionResult().Denominator,
+		}
+	}
+	if withComplex, ok := response.(interface{ GetComplexResult() *v1.Complex }); ok && withComplex.GetComplexResult() != nil {
+		httpResponse.ComplexResult = &Complex{
+			Re: Float(withComplex.GetComplexResult().Re),
+			Im: Float(withComplex.GetComplexResult().Im),
+		}
+	}
+	if withInterval, ok := response.(interface{ GetIntervalResult() *v1.Interval }); ok && withInterval.GetIntervalResult() != nil {
+		httpResponse.IntervalResult = &Interval{
+			Lo: withInterval.GetIntervalResult().Lo,
+			Hi: withInterval.GetIntervalResult().Hi,
+		}
+	}
+	return httpResponse
+}
+
+func (h *WebHandler) EvaluateHandler(w http.ResponseWriter, r *http.Request) {
+	// Set content type to JSON
+	w.Header().Set("Content-Type", "application/json")
+
+	// Decode request body
+	var evaluateRequest EvaluateRequest
+	if err := json.NewDecoder(r.Body).Decode(&evaluateRequest); err != nil {
+		h.logger.Error().
+			Err(err).
+			Msg("Failed to decode request body")
+
+		response := EvaluateResponse{
+			RequestID: "error-request-id",
+			Error: &ErrorInfo{
+				Code:     "BAD_REQUEST",
+				Message:  "Invalid request body",
+				Severity: "ERROR",
+			},
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	// Perform evaluation
+	start := time.Now()
+	response, err := fromStatus(h.calculationClient.Evaluate(context.Background(), &v1.EvaluateRequest{
+		Expression: evaluateRequest.Expression,
+	}))
+
+	// Log evaluation details
+	duration := time.Since(start)
+	logFields := map[string]interface{}{
+		"operation":      "evaluate",
+		"request_id":     response.GetRequestId(),
+		"expression":     evaluateRequest.Expression,
+		"duration_ms":    duration.Milliseconds(),
+		"calculation_ok": err == nil && response.GetError() == nil,
+	}
+
+	// Handle gRPC error or service-level error
+	if err != nil || response.GetError() != nil {
+		httpResponse := EvaluateResponse{
+			RequestID: response.GetRequestId(),
+		}
+
+		if err != nil && response.GetError() == nil {
+			httpResponse.Error = &ErrorInfo{
+				Code:     "GRPC_ERROR",
+				Message:  err.Error(),
+				Severity: "ERROR",
+			}
+		} else {
+			httpResponse.Error = toErrorInfo(response.GetError())
+			for _, parseErr := range response.GetParseErrors() {
+				httpResponse.ParseErrors = append(httpResponse.ParseErrors, *toErrorInfo(parseErr))
+			}
+		}
+
+		h.logger.Error().
+			Str("error_code", httpResponse.Error.Code).
+			Str("error_message", httpResponse.Error.Message).
+			Fields(logFields).
+			Msg("Evaluation failed")
+
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(httpResponse)
+		return
+	}
+
+	// Log successful evaluation
+	h.logger.Info().
+		Fields(logFields).
+		Msg("Evaluation completed successfully")
+
+	// Prepare HTTP response
+	httpResponse := EvaluateResponse{
 		Result:    response.Result,
 		RequestID: response.RequestId,
 	}
 
 	// Add calculation metadata if available
-	if response.CalculationMetadata != nil {
-		httpResponse.CalculationMetadata = &CalcMetadata{
-			CalculationTime:   response.CalculationMetadata.CalculationTime.AsTime().String(),
-			NumbersProcessed:  response.CalculationMetadata.NumbersProcessed,
-			CalculationMethod: response.CalculationMetadata.CalculationMethod,
+	httpResponse.CalculationMetadata = toCalcMetadata(response.CalculationMetadata)
+
+	// Send response
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(httpResponse)
+}
+
+// toCalcMetadata converts calculation metadata into its JSON form, if present
+func toCalcMetadata(metadata *v1.AddResponse_CalculationMetadata) *CalcMetadata {
+	if metadata == nil {
+		return nil
+	}
+	return &CalcMetadata{
+		CalculationTime:   metadata.CalculationTime.AsTime().String(),
+		NumbersProcessed:  metadata.NumbersProcessed,
+		CalculationMethod: metadata.CalculationMethod,
+		RoundingMode:      roundingModeName(metadata.RoundingMode),
+		ClampedCount:      metadata.ClampedCount,
+		DroppedCount:      metadata.DroppedCount,
+		Warnings:          toWarnings(metadata.Warnings),
+	}
+}
+
+// toWarnings converts the warnings of a calculation into their JSON form
+func toWarnings(warnings []*v1.AddResponse_ErrorInfo) []ErrorInfo {
+	if len(warnings) == 0 {
+		return nil
+	}
+	httpWarnings := make([]ErrorInfo, len(warnings))
+	for i, warning := range warnings {
+		httpWarnings[i] = *toErrorInfo(warning)
+	}
+	return httpWarnings
+}
+
+// toErrorInfo converts a gRPC error description into its JSON form
+func toErrorInfo(errInfo *v1.AddResponse_ErrorInfo) *ErrorInfo {
+	return &ErrorInfo{
+		Code:       errInfo.Code,
+		Message:    errInfo.Message,
+		Severity:   errInfo.Severity.String(),
+		Position:   errInfo.Position,
+		Violations: toViolations(errInfo.Violations),
+	}
+}
+
+// toViolations converts constraint violations into their JSON form
+func toViolations(violations []*v1.AddResponse_ErrorInfo_Violation) []Violation {
+	if len(violations) == 0 {
+		return nil
+	}
+	httpViolations := make([]Violation, len(violations))
+	for i, violation := range violations {
+		httpViolations[i] = Violation{
+			Index:   violation.Index,
+			Value:   Float(violation.Value),
+			Rule:    violation.Rule,
+			Code:    violation.Code,
+			Message: violation.Message,
 		}
 	}
+	return httpViolations
+}
+
+func (h *WebHandler) DescribeHandler(w http.ResponseWriter, r *http.Request) {
+	// Set content type to JSON
+	w.Header().Set("Content-Type", "application/json")
+
+	// Decode request body
+	var describeRequest DescribeRequest
+	if err := json.NewDecoder(r.Body).Decode(&describeRequest); err != nil {
+		h.logger.Error().
+			Err(err).
+			Msg("Failed to decode request body")
+
+		response := DescribeResponse{
+			RequestID: "error-request-id",
+			Error: &ErrorInfo{
+				Code:     "BAD_REQUEST",
+				Message:  "Invalid request body",
+				Severity: "ERROR",
+			},
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	// Perform calculation
+	start := time.Now()
+	response, err := fromStatus(h.calculationClient.Describe(context.Background(), &v1.DescribeRequest{
+		RequestId:   describeRequest.RequestID,
+		Numbers:     describeRequest.Numbers,
+		Constraints: describeRequest.constraints(),
+		Percentiles: describeRequest.Percentiles,
+	}))
+
+	// Log calculation details
+	duration := time.Since(start)
+	logFields := map[string]interface{}{
+		"operation":      "describe",
+		"request_id":     response.GetRequestId(),
+		"numbers_count":  len(describeRequest.Numbers),
+		"duration_ms":    duration.Milliseconds(),
+		"calculation_ok": err == nil && response.GetError() == nil,
+	}
+
+	// Handle gRPC error or service-level error
+	if err != nil || response.GetError() != nil {
+		errorInfo := &ErrorInfo{
+			Code:     "GRPC_ERROR",
+			Severity: "ERROR",
+		}
+		if err != nil {
+			errorInfo.Message = err.Error()
+		} else {
+			errorInfo = toErrorInfo(response.GetError())
+		}
+
+		h.logger.Error().
+			Str("error_code", errorInfo.Code).
+			Str("error_message", errorInfo.Message).
+			Fields(logFields).
+			Msg("Calculation failed")
+
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(DescribeResponse{
+			RequestID: response.GetRequestId(),
+			Error:     errorInfo,
+		})
+		return
+	}
+
+	// Log successful calculation
+	h.logger.Info().
+		Fields(logFields).
+		Msg("Calculation completed successfully")
+
+	// Prepare HTTP response
+	httpResponse := DescribeResponse{
+		Sum:                     Float(response.Sum),
+		Mean:                    Float(response.Mean),
+		Variance:                Float(response.Variance),
+		StandardDeviation:       Float(response.StandardDeviation),
+		SampleVariance:          Float(response.SampleVariance),
+		SampleStandardDeviation: Float(response.SampleStandardDeviation),
+		Min:                     Float(response.Min),
+		Max:                     Float(response.Max),
+		Median:                  Float(response.Median),
+		RequestID:               response.RequestId,
+	}
+	for _, p := range response.Percentiles {
+		httpResponse.Percentiles = append(httpResponse.Percentiles, Percentile{
+			Percentile: p.Percentile,
+			Value:      Float(p.Value),
+		})
+	}
+
+	// Add calculation metadata if available
+	httpResponse.CalculationMetadata = toCalcMetadata(response.CalculationMetadata)
+
+	// Send response
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(httpResponse)
+}
+
+func (h *WebHandler) BatchAddHandler(w http.ResponseWriter, r *http.Request) {
+	// Set content type to JSON
+	w.Header().Set("Content-Type", "application/json")
+
+	// Decode request body
+	var batchRequest BatchAddRequest
+	if err := json.NewDecoder(r.Body).Decode(&batchRequest); err != nil {
+		h.logger.Error().
+			Err(err).
+			Msg("Failed to decode request body")
+
+		response := BatchAddResponse{
+			RequestID: "error-request-id",
+			Error: &ErrorInfo{
+				Code:     "BAD_REQUEST",
+				Message:  "Invalid request body",
+				Severity: "ERROR",
+			},
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	// Prepare gRPC request
+	grpcRequest := &v1.BatchAddRequest{
+		Requests: make([]*v1.AddRequest, len(batchRequest.Requests)),
+	}
+	for i, item := range batchRequest.Requests {
+		grpcRequest.Requests[i] = item.toProto()
+	}
+
+	// Perform calculations
+	start := time.Now()
+	response, err := fromStatus(h.calculationClient.BatchAdd(context.Background(), grpcRequest))
+
+	// Log calculation details
+	duration := time.Since(start)
+	logFields := map[string]interface{}{
+		"operation":      "batch_add",
+		"request_id":     response.GetRequestId(),
+		"batch_size":     len(batchRequest.Requests),
+		"failed_count":   response.GetFailedCount(),
+		"duration_ms":    duration.Milliseconds(),
+		"calculation_ok": err == nil && response.GetError() == nil,
+	}
+
+	// Handle gRPC error or batch-level error
+	if err != nil || response.GetError() != nil {
+		errorInfo := &ErrorInfo{
+			Code:     "GRPC_ERROR",
+			Severity: "ERROR",
+		}
+		if err != nil {
+			errorInfo.Message = err.Error()
+		} else {
+			errorInfo = toErrorInfo(response.GetError())
+		}
+
+		h.logger.Error().
+			Str("error_code", errorInfo.Code).
+			Str("error_message", errorInfo.Message).
+			Fields(logFields).
+			Msg("Batch calculation failed")
+
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode(BatchAddResponse{
+			RequestID: response.GetRequestId(),
+			Error:     errorInfo,
+		})
+		return
+	}
+
+	// Log completed batch, failed items are reported per item
+	h.logger.Info().
+		Fields(logFields).
+		Msg("Batch calculation completed")
+
+	// Prepare HTTP response
+	httpResponse := BatchAddResponse{
+		Responses:   make([]AddResponse, len(response.Responses)),
+		FailedCount: response.FailedCount,
+		RequestID:   response.RequestId,
+	}
+	for i, item := range response.Responses {
+		httpResponse.Responses[i] = toAddResponse(item)
+	}
 
 	// Send response
 	w.WriteHeader(http.StatusOK)
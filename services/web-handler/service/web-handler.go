@@ -1,6 +1,8 @@
 package service
 
 import (
+	"encoding/json"
+
 	pb "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
 	"github.com/yourusername/proto-buf-experiment/pkg/logging"
 	internal "github.com/yourusername/proto-buf-experiment/services/web-handler/internal"
@@ -13,30 +15,227 @@ func NewWebHandler(calculationClient pb.AdditionServiceClient, logger logging.Lo
 
 // AddRequest represents the request structure for addition operations
 type AddRequest struct {
-	Numbers    []float64 `json:"numbers"`
-	MinValue   *float64  `json:"min_value,omitempty"`
-	MaxValue   *float64  `json:"max_value,omitempty"`
-	MaxNumbers *int32    `json:"max_numbers,omitempty"`
+	RequestID        string             `json:"request_id,omitempty"`
+	Numbers          Floats             `json:"numbers"`
+	MinValue         *float64           `json:"min_value,omitempty"`
+	MaxValue         *float64           `json:"max_value,omitempty"`
+	MaxNumbers       *int32             `json:"max_numbers,omitempty"`
+	MinSum           *float64           `json:"min_sum,omitempty"`
+	MaxSum           *float64           `json:"max_sum,omitempty"`
+	MinNumbers       *int32             `json:"min_numbers,omitempty"`
+	IntegerOnly      bool               `json:"integer_only,omitempty"`
+	MaxDecimalPlaces *int32             `json:"max_decimal_places,omitempty"`
+	FiniteOnly       bool               `json:"finite_only,omitempty"`
+	NonNegative      bool               `json:"non_negative,omitempty"`
+	Unique           bool               `json:"unique,omitempty"`
+	Actions          *ConstraintActions `json:"actions,omitempty"`
+	NaNPolicy        string             `json:"nan_policy,omitempty"`
+	InfinityPolicy   string             `json:"infinity_policy,omitempty"`
+	ValidationMode   string             `json:"validation_mode,omitempty"`
+	Units            []string           `json:"units,omitempty"`
+	ResultUnit       *string            `json:"result_unit,omitempty"`
+	Money            []Money            `json:"money,omitempty"`
+	MoneyOptions     *MoneyOptions      `json:"money_options,omitempty"`
+	Fractions        []Fraction         `json:"fractions,omitempty"`
+	Complex          []Complex          `json:"complex,omitempty"`
+	Intervals        []Interval         `json:"intervals,omitempty"`
 }
 
+// Float is a float64 whose JSON form can also hold NaN and infinities, as the
+// strings "NaN", "Infinity" and "-Infinity"
+type Float = internal.Float
+
+// Floats is a list of numbers encoded like Float
+type Floats = internal.Floats
+
 // AddResponse represents the response structure for addition operations
 type AddResponse struct {
 	Result              float64       `json:"result"`
+	Unit                string        `json:"unit,omitempty"`
+	MoneyResult         *Money        `json:"money_result,omitempty"`
+	FractionResult      *Fraction     `json:"fraction_result,omitempty"`
+	ComplexResult       *Complex      `json:"complex_result,omitempty"`
+	IntervalResult      *Interval     `json:"interval_result,omitempty"`
 	Error               *ErrorInfo    `json:"error,omitempty"`
 	RequestID           string        `json:"request_id"`
 	CalculationMetadata *CalcMetadata `json:"calculation_metadata,omitempty"`
 }
 
+// Money represents a monetary amount in an ISO 4217 currency
+type Money struct {
+	Currency string `json:"currency"`
+	Amount   string `json:"amount"`
+}
+
+// ConstraintActions selects how operands violating a constraint are handled:
+// "reject" (the default), "clamp" or "drop". Violations of min_sum, max_sum
+// and min_numbers are rejected or, with "warn", reported as warnings.
+type ConstraintActions struct {
+	MinValue         string `json:"min_value,omitempty"`
+	MaxValue         string `json:"max_value,omitempty"`
+	MaxNumbers       string `json:"max_numbers,omitempty"`
+	IntegerOnly      string `json:"integer_only,omitempty"`
+	MaxDecimalPlaces string `json:"max_decimal_places,omitempty"`
+	NonNegative      string `json:"non_negative,omitempty"`
+	Unique           string `json:"unique,omitempty"`
+	FiniteOnly       string `json:"finite_only,omitempty"`
+	MinSum           string `json:"min_sum,omitempty"`
+	MaxSum           string `json:"max_sum,omitempty"`
+	MinNumbers       string `json:"min_numbers,omitempty"`
+}
+
+// MoneyOptions configures the result currency, currency conversion and
+// rounding of money additions
+type MoneyOptions struct {
+	Currency          *string `json:"currency,omitempty"`
+	ConvertCurrencies bool    `json:"convert_currencies,omitempty"`
+	RoundingMode      string  `json:"rounding_mode,omitempty"`
+}
+
+// Fraction represents an exact rational number
+type Fraction struct {
+	Numerator   string `json:"numerator"`
+	Denominator string `json:"denominator,omitempty"`
+}
+
+// Complex represents a complex number re + im*i
+type Complex struct {
+	Re Float `json:"re"`
+	Im Float `json:"im"`
+}
+
+// Interval represents the closed interval [lo, hi], or a value with a
+// symmetric uncertainty when Value is set
+type Interval struct {
+	Lo          float64  `json:"lo"`
+	Hi          float64  `json:"hi"`
+	Value       *float64 `json:"value,omitempty"`
+	Uncertainty float64  `json:"uncertainty,omitempty"`
+}
+
 // ErrorInfo provides detailed error information
 type ErrorInfo struct {
-	Code     string `json:"code"`
-	Message  string `json:"message"`
-	Severity string `json:"severity"`
+	Code       string      `json:"code"`
+	Message    string      `json:"message"`
+	Severity   string      `json:"severity"`
+	Position   *int32      `json:"position,omitempty"`
+	Violations []Violation `json:"violations,omitempty"`
+}
+
+// Violation describes a constraint violated by a single operand
+type Violation struct {
+	Index   int32  `json:"index"`
+	Value   Float  `json:"value"`
+	Rule    string `json:"rule"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// BatchAddRequest represents the request structure for batch additions
+type BatchAddRequest struct {
+	Requests []AddRequest `json:"requests"`
+}
+
+// BatchAddResponse represents the response structure for batch additions
+type BatchAddResponse struct {
+	Responses   []AddResponse `json:"responses"`
+	FailedCount int32         `json:"failed_count"`
+	Error       *ErrorInfo    `json:"error,omitempty"`
+	RequestID   string        `json:"request_id"`
+}
+
+// EvaluateRequest represents the request structure for expression evaluation
+type EvaluateRequest struct {
+	Expression string `json:"expression"`
+}
+
+// EvaluateResponse represents the response structure for expression evaluation
+type EvaluateResponse struct {
+	Result              float64       `json:"result"`
+	Error               *ErrorInfo    `json:"error,omitempty"`
+	ParseErrors         []ErrorInfo   `json:"parse_errors,omitempty"`
+	RequestID           string        `json:"request_id"`
+	CalculationMetadata *CalcMetadata `json:"calculation_metadata,omitempty"`
+}
+
+// DescribeRequest represents the request structure for descriptive statistics
+type DescribeRequest struct {
+	AddRequest
+	Percentiles []float64 `json:"percentiles,omitempty"`
+}
+
+// DescribeResponse represents the response structure for descriptive statistics
+type DescribeResponse struct {
+	Sum                     Float         `json:"sum"`
+	Mean                    Float         `json:"mean"`
+	Variance                Float         `json:"variance"`
+	StandardDeviation       Float         `json:"standard_deviation"`
+	SampleVariance          Float         `json:"sample_variance"`
+	SampleStandardDeviation Float         `json:"sample_standard_deviation"`
+	Min                     Float         `json:"min"`
+	Max                     Float         `json:"max"`
+	Median                  Float         `json:"median"`
+	Percentiles             []Percentile  `json:"percentiles,omitempty"`
+	Error                   *ErrorInfo    `json:"error,omitempty"`
+	RequestID               string        `json:"request_id"`
+	CalculationMetadata     *CalcMetadata `json:"calculation_metadata,omitempty"`
+}
+
+// Percentile is the value of a requested percentile
+type Percentile struct {
+	Percentile float64 `json:"percentile"`
+	Value      Float   `json:"value"`
 }
 
 // CalcMetadata provides metadata about the calculation
 type CalcMetadata struct {
-	CalculationTime   string `json:"calculation_time"`
-	NumbersProcessed  int32  `json:"numbers_processed"`
-	CalculationMethod string `json:"calculation_method"`
+	CalculationTime   string      `json:"calculation_time"`
+	NumbersProcessed  int32       `json:"numbers_processed"`
+	CalculationMethod string      `json:"calculation_method"`
+	RoundingMode      string      `json:"rounding_mode,omitempty"`
+	ClampedCount      int32       `json:"clamped_count,omitempty"`
+	DroppedCount      int32       `json:"dropped_count,omitempty"`
+	Warnings          []ErrorInfo `json:"warnings,omitempty"`
+}
+
+// UnmarshalJSON decodes the response, accepting a non-finite result string
+func (r *AddResponse) UnmarshalJSON(data []byte) error {
+	type plain AddResponse
+	aux := struct {
+		*plain
+		Result Float `json:"result"`
+	}{plain: (*plain)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Result = float64(aux.Result)
+	return nil
+}
+
+// Calculation represents a past calculation from the calculation history. The
+// request and response are the JSON forms of the gRPC messages.
+type Calculation struct {
+	RequestID         string          `json:"request_id"`
+	Operation         string          `json:"operation"`
+	Request           json.RawMessage `json:"request,omitempty"`
+	Response          json.RawMessage `json:"response,omitempty"`
+	ErrorCode         string          `json:"error_code,omitempty"`
+	CalculationMethod string          `json:"calculation_method,omitempty"`
+	CalculationTime   string          `json:"calculation_time"`
+	DurationMs        float64         `json:"duration_ms"`
+	NumbersProcessed  int32           `json:"numbers_processed,omitempty"`
+	Truncated         bool            `json:"truncated,omitempty"`
+}
+
+// ListCalculationsResponse represents a page of past calculations
+type ListCalculationsResponse struct {
+	Calculations  []Calculation `json:"calculations"`
+	NextPageToken string        `json:"next_page_token,omitempty"`
+	Error         *ErrorInfo    `json:"error,omitempty"`
+}
+
+// GetCalculationResponse represents a past calculation looked up by request ID
+type GetCalculationResponse struct {
+	Calculation *Calculation `json:"calculation,omitempty"`
+	Error       *ErrorInfo   `json:"error,omitempty"`
 }
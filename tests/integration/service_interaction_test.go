@@ -1,9 +1,16 @@
 package integrationtest
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"io"
 	"log"
+	"math"
+	"math/rand"
 	"net"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -11,10 +18,13 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
 
 	pb "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/pkg/logging"
 	calculationService "github.com/yourusername/proto-buf-experiment/services/calculation/service"
+	webhandler "github.com/yourusername/proto-buf-experiment/services/web-handler/service"
 )
 
 const bufSize = 1024 * 1024
@@ -23,7 +33,7 @@ var lis *bufconn.Listener
 
 func init() {
 	lis = bufconn.Listen(bufSize)
-	s := grpc.NewServer()
+	s := grpc.NewServer(grpc.UnaryInterceptor(calculationService.UnaryServerInterceptor()))
 	calculationSvc := calculationService.NewAdditionService()
 	pb.RegisterAdditionServiceServer(s, calculationSvc)
 	
@@ -157,3 +167,792 @@ func TestServiceInteraction_ErrorHandling(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceInteraction_AddStream(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx,
+		"bufnet",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(bufDialer),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client := pb.NewAdditionServiceClient(conn)
+
+	maxNumbers := int32(5)
+	negativeMax := int32(-1)
+	maxValue := 10.0
+	minSum := 100.0
+
+	testCases := []struct {
+		name             string
+		chunks           [][]float64
+		constraints      *pb.AddRequest_Constraints
+		expectedResult   float64
+		expectedCount    int32
+		expectedWarnings int
+		expectedError    string
+		expectedCode     string
+	}{
+		{
+			name:           "Multiple Chunks",
+			chunks:         [][]float64{{1.0, 2.0}, {3.0}, {4.0, 5.0}},
+			expectedResult: 15.0,
+			expectedCount:  5,
+		},
+		{
+			name:          "Max Numbers Across Chunks",
+			chunks:        [][]float64{{1.0, 2.0, 3.0}, {4.0, 5.0, 6.0}},
+			constraints:   &pb.AddRequest_Constraints{MaxNumbers: &maxNumbers},
+			expectedError: "too many numbers",
+			expectedCode:  "CONSTRAINT_VIOLATION",
+		},
+		{
+			name:          "Negative Max Numbers With Empty First Chunk",
+			chunks:        [][]float64{{}, {1.0}},
+			constraints:   &pb.AddRequest_Constraints{MaxNumbers: &negativeMax},
+			expectedError: "too many numbers",
+			expectedCode:  "CONSTRAINT_VIOLATION",
+		},
+		{
+			name:   "Negative Max Numbers Collected With Empty First Chunk",
+			chunks: [][]float64{{}, {1.0}},
+			constraints: &pb.AddRequest_Constraints{
+				MaxNumbers:     &negativeMax,
+				ValidationMode: pb.ValidationMode_VALIDATION_MODE_COLLECT_ALL,
+			},
+			expectedError: "constraint violations",
+			expectedCode:  "CONSTRAINT_VIOLATIONS",
+		},
+		{
+			name:          "Max Value In Later Chunk",
+			chunks:        [][]float64{{1.0}, {2.0, 30.0}},
+			constraints:   &pb.AddRequest_Constraints{MaxValue: &maxValue},
+			expectedError: "number above maximum",
+			expectedCode:  "VALUE_TOO_HIGH",
+		},
+		{
+			name:   "Warn Below Min Sum",
+			chunks: [][]float64{{1.0, 2.0}, {3.0}},
+			constraints: &pb.AddRequest_Constraints{
+				MinSum:       &minSum,
+				MinSumAction: pb.ConstraintAction_CONSTRAINT_ACTION_WARN,
+			},
+			expectedResult:   6.0,
+			expectedCount:    3,
+			expectedWarnings: 1,
+		},
+		{
+			name:          "Empty Stream",
+			chunks:        nil,
+			expectedError: "no numbers provided",
+			expectedCode:  "NO_NUMBERS",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			stream, err := client.AddStream(ctx)
+			require.NoError(t, err)
+
+			requestID := "integration-stream-test-" + tc.name
+			for i, chunk := range tc.chunks {
+				req := &pb.AddStreamRequest{Numbers: chunk}
+				if i == 0 {
+					req.RequestId = requestID
+					req.Constraints = tc.constraints
+				}
+				if err := stream.Send(req); err != nil {
+					break
+				}
+			}
+
+			resp, err := stream.CloseAndRecv()
+
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedError)
+				detailed := statusResponse[*pb.AddResponse](t, err)
+				require.NotNil(t, detailed.Error)
+				assert.Equal(t, tc.expectedCode, detailed.Error.Code)
+				if len(tc.chunks) > 0 {
+					assert.Equal(t, requestID, detailed.RequestId)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			assert.InDelta(t, tc.expectedResult, resp.Result, 1e-9)
+			assert.Equal(t, requestID, resp.RequestId)
+			require.NotNil(t, resp.CalculationMetadata)
+			assert.Equal(t, tc.expectedCount, resp.CalculationMetadata.NumbersProcessed)
+			assert.Len(t, resp.CalculationMetadata.Warnings, tc.expectedWarnings)
+		})
+	}
+}
+
+func TestServiceInteraction_AddStreamLarge(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx,
+		"bufnet",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(bufDialer),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client := pb.NewAdditionServiceClient(conn)
+
+	rng := rand.New(rand.NewSource(11))
+	numbers := make([]float64, 1<<20+3)
+	for i := range numbers {
+		numbers[i] = rng.NormFloat64() * math.Pow(10, float64(rng.Intn(12)))
+	}
+
+	stream, err := client.AddStream(ctx)
+	require.NoError(t, err)
+	for start := 0; start < len(numbers); start += 1 << 16 {
+		end := min(start+1<<16, len(numbers))
+		require.NoError(t, stream.Send(&pb.AddStreamRequest{Numbers: numbers[start:end]}))
+	}
+	resp, err := stream.CloseAndRecv()
+	require.NoError(t, err)
+
+	// A long stream is added like a unary Add of the same operands
+	unary, err := calculationService.NewAdditionService().Add(ctx, &pb.AddRequest{Numbers: numbers})
+	require.NoError(t, err)
+	assert.Equal(t, "parallel_pairwise_summation", unary.CalculationMetadata.CalculationMethod)
+	assert.Equal(t, unary.CalculationMetadata.CalculationMethod, resp.CalculationMetadata.CalculationMethod)
+	assert.Equal(t, math.Float64bits(unary.Result), math.Float64bits(resp.Result))
+	assert.Equal(t, int32(len(numbers)), resp.CalculationMetadata.NumbersProcessed)
+}
+
+func TestServiceInteraction_AddStreamViolations(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx,
+		"bufnet",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(bufDialer),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client := pb.NewAdditionServiceClient(conn)
+
+	send := func(constraints *pb.AddRequest_Constraints, chunks ...[]float64) *pb.AddResponse {
+		stream, err := client.AddStream(ctx)
+		require.NoError(t, err)
+		for i, chunk := range chunks {
+			req := &pb.AddStreamRequest{Numbers: chunk}
+			if i == 0 {
+				req.Constraints = constraints
+			}
+			require.NoError(t, stream.Send(req))
+		}
+		_, err = stream.CloseAndRecv()
+		require.Error(t, err)
+		detailed := statusResponse[*pb.AddResponse](t, err)
+		require.NotNil(t, detailed.Error)
+		return detailed
+	}
+
+	// Duplicates are reported at their position in the stream
+	resp := send(&pb.AddRequest_Constraints{
+		Unique:    true,
+		NanPolicy: pb.NonFinitePolicy_NON_FINITE_POLICY_SKIP,
+	}, []float64{1, 2}, []float64{math.NaN(), 1})
+	assert.Equal(t, "DUPLICATE_VALUE", resp.Error.Code)
+	assert.Contains(t, resp.Error.Message, "position 3 duplicates position 0")
+
+	// Collect-all reports every violation across the chunks
+	maxValue := 10.0
+	resp = send(&pb.AddRequest_Constraints{
+		MaxValue:       &maxValue,
+		Unique:         true,
+		NanPolicy:      pb.NonFinitePolicy_NON_FINITE_POLICY_SKIP,
+		ValidationMode: pb.ValidationMode_VALIDATION_MODE_COLLECT_ALL,
+	}, []float64{50, 1}, []float64{math.NaN(), 1, 20})
+	assert.Equal(t, "CONSTRAINT_VIOLATIONS", resp.Error.Code)
+	require.Len(t, resp.Error.Violations, 3)
+	assert.Equal(t, int32(0), resp.Error.Violations[0].Index)
+	assert.Equal(t, int32(3), resp.Error.Violations[1].Index)
+	assert.Equal(t, "DUPLICATE_VALUE", resp.Error.Violations[1].Code)
+	assert.Equal(t, int32(4), resp.Error.Violations[2].Index)
+}
+
+func TestServiceInteraction_AddStreamNonFiniteMatchesAdd(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx,
+		"bufnet",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(bufDialer),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client := pb.NewAdditionServiceClient(conn)
+
+	numbers := []float64{1, math.NaN(), 2, math.Inf(1)}
+	testCases := []struct {
+		name            string
+		constraints     *pb.AddRequest_Constraints
+		expectedCode    string
+		expectedDropped int32
+	}{
+		{
+			name:         "Rejected By Default",
+			expectedCode: "NAN_INPUT",
+		},
+		{
+			name: "Dropped By Finite Only",
+			constraints: &pb.AddRequest_Constraints{
+				FiniteOnly:       true,
+				FiniteOnlyAction: pb.ConstraintAction_CONSTRAINT_ACTION_DROP,
+			},
+			expectedDropped: 2,
+		},
+		{
+			name: "Skipped By Policy",
+			constraints: &pb.AddRequest_Constraints{
+				NanPolicy:      pb.NonFinitePolicy_NON_FINITE_POLICY_SKIP,
+				InfinityPolicy: pb.NonFinitePolicy_NON_FINITE_POLICY_SKIP,
+			},
+		},
+		{
+			name: "Unknown Policy Collected",
+			constraints: &pb.AddRequest_Constraints{
+				NanPolicy:      pb.NonFinitePolicy(99),
+				InfinityPolicy: pb.NonFinitePolicy(99),
+				ValidationMode: pb.ValidationMode_VALIDATION_MODE_COLLECT_ALL,
+			},
+			expectedCode: "CONSTRAINT_VIOLATIONS",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			unary, unaryErr := client.Add(ctx, &pb.AddRequest{Numbers: numbers, Constraints: tc.constraints})
+
+			stream, err := client.AddStream(ctx)
+			require.NoError(t, err)
+			require.NoError(t, stream.Send(&pb.AddStreamRequest{Numbers: numbers[:2], Constraints: tc.constraints}))
+			require.NoError(t, stream.Send(&pb.AddStreamRequest{Numbers: numbers[2:]}))
+			streamed, streamErr := stream.CloseAndRecv()
+
+			// The unary and streaming additions apply the same policies
+			if tc.expectedCode != "" {
+				require.Error(t, unaryErr)
+				require.Error(t, streamErr)
+				unaryResp := statusResponse[*pb.AddResponse](t, unaryErr)
+				streamResp := statusResponse[*pb.AddResponse](t, streamErr)
+				assert.Equal(t, tc.expectedCode, unaryResp.Error.Code)
+				assert.Equal(t, tc.expectedCode, streamResp.Error.Code)
+				assert.Len(t, streamResp.Error.Violations, len(unaryResp.Error.Violations))
+				return
+			}
+
+			require.NoError(t, unaryErr)
+			require.NoError(t, streamErr)
+			assert.Equal(t, 3.0, unary.Result)
+			assert.Equal(t, unary.Result, streamed.Result)
+			assert.Equal(t, tc.expectedDropped, unary.CalculationMetadata.DroppedCount)
+			assert.Equal(t, tc.expectedDropped, streamed.CalculationMetadata.DroppedCount)
+		})
+	}
+}
+
+func TestServiceInteraction_AddProgress(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx,
+		"bufnet",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(bufDialer),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client := pb.NewAdditionServiceClient(conn)
+
+	stream, err := client.AddProgress(ctx, &pb.AddProgressRequest{
+		Numbers:            []float64{1.0, 2.0, 3.0, 4.0, 5.0, 6.0, 7.0},
+		RequestId:          "integration-progress-test",
+		ReportEveryNumbers: 3,
+	})
+	require.NoError(t, err)
+
+	var responses []*pb.AddResponse
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		responses = append(responses, resp)
+	}
+
+	// Two partial totals after 3 and 6 numbers, then the final sum
+	require.Len(t, responses, 3)
+	expected := []struct {
+		result    float64
+		processed int32
+		partial   bool
+	}{
+		{result: 6.0, processed: 3, partial: true},
+		{result: 21.0, processed: 6, partial: true},
+		{result: 28.0, processed: 7, partial: false},
+	}
+	for i, resp := range responses {
+		assert.Equal(t, "integration-progress-test", resp.RequestId)
+		assert.InDelta(t, expected[i].result, resp.Result, 1e-9)
+		require.NotNil(t, resp.CalculationMetadata)
+		assert.Equal(t, expected[i].processed, resp.CalculationMetadata.NumbersProcessed)
+		assert.Equal(t, expected[i].partial, resp.CalculationMetadata.Partial)
+	}
+}
+
+func TestServiceInteraction_AddProgressNonFinite(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx,
+		"bufnet",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(bufDialer),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client := pb.NewAdditionServiceClient(conn)
+
+	last := func(req *pb.AddProgressRequest) (*pb.AddResponse, error) {
+		stream, err := client.AddProgress(ctx, req)
+		require.NoError(t, err)
+		var resp *pb.AddResponse
+		for {
+			next, err := stream.Recv()
+			if err == io.EOF {
+				return resp, nil
+			}
+			if err != nil {
+				return resp, err
+			}
+			resp = next
+		}
+	}
+
+	// A propagated infinity keeps the running total infinite
+	resp, err := last(&pb.AddProgressRequest{
+		Numbers:            []float64{1, math.Inf(1), 2, 3},
+		ReportEveryNumbers: 1,
+		Constraints: &pb.AddRequest_Constraints{
+			InfinityPolicy: pb.NonFinitePolicy_NON_FINITE_POLICY_PROPAGATE,
+		},
+	})
+	require.NoError(t, err)
+	assert.True(t, math.IsInf(resp.Result, 1))
+	assert.False(t, resp.CalculationMetadata.Partial)
+
+	// Finite operands overflowing the running total fail
+	resp, err = last(&pb.AddProgressRequest{
+		Numbers: []float64{math.MaxFloat64, math.MaxFloat64, 1},
+	})
+	require.Error(t, err)
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, "OVERFLOW", resp.Error.Code)
+}
+
+func TestServiceInteraction_Session(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx,
+		"bufnet",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(bufDialer),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client := pb.NewAdditionServiceClient(conn)
+
+	stream, err := client.Session(ctx)
+	require.NoError(t, err)
+
+	maxValue := 100.0
+
+	steps := []struct {
+		request        *pb.SessionRequest
+		expectedResult float64
+		expectedCode   string
+	}{
+		{
+			request:        &pb.SessionRequest{RequestId: "step-1", Operation: pb.SessionRequest_OPERATION_ADD, Value: 5},
+			expectedResult: 5,
+		},
+		{
+			request:        &pb.SessionRequest{RequestId: "step-2", Operation: pb.SessionRequest_OPERATION_SUBTRACT, Value: 2},
+			expectedResult: 3,
+		},
+		{
+			request: &pb.SessionRequest{
+				RequestId:   "step-3",
+				Operation:   pb.SessionRequest_OPERATION_ADD,
+				Value:       500,
+				Constraints: &pb.AddRequest_Constraints{MaxValue: &maxValue},
+			},
+			expectedResult: 3,
+			expectedCode:   "VALUE_TOO_HIGH",
+		},
+		{
+			request:        &pb.SessionRequest{RequestId: "step-4", Operation: pb.SessionRequest_OPERATION_TOTAL},
+			expectedResult: 3,
+		},
+		{
+			request:        &pb.SessionRequest{RequestId: "step-5", Operation: pb.SessionRequest_OPERATION_CLEAR},
+			expectedResult: 0,
+		},
+		{
+			request:        &pb.SessionRequest{RequestId: "step-6", Operation: pb.SessionRequest_OPERATION_ADD, Value: 1.5},
+			expectedResult: 1.5,
+		},
+		{
+			request:        &pb.SessionRequest{RequestId: "step-7", Operation: pb.SessionRequest_OPERATION_ADD, Value: math.NaN()},
+			expectedResult: 1.5,
+			expectedCode:   "NAN_INPUT",
+		},
+		{
+			request: &pb.SessionRequest{
+				RequestId:   "step-8",
+				Operation:   pb.SessionRequest_OPERATION_SUBTRACT,
+				Value:       math.NaN(),
+				Constraints: &pb.AddRequest_Constraints{NanPolicy: pb.NonFinitePolicy_NON_FINITE_POLICY_SKIP},
+			},
+			expectedResult: 1.5,
+		},
+		{
+			request:        &pb.SessionRequest{RequestId: "step-9", Operation: pb.SessionRequest_OPERATION_ADD, Value: math.MaxFloat64},
+			expectedResult: math.MaxFloat64,
+		},
+		{
+			request:        &pb.SessionRequest{RequestId: "step-10", Operation: pb.SessionRequest_OPERATION_ADD, Value: math.MaxFloat64},
+			expectedResult: math.MaxFloat64,
+			expectedCode:   "OVERFLOW",
+		},
+	}
+
+	for _, step := range steps {
+		require.NoError(t, stream.Send(step.request))
+
+		resp, err := stream.Recv()
+		require.NoError(t, err)
+
+		assert.Equal(t, step.request.RequestId, resp.RequestId)
+		assert.InDelta(t, step.expectedResult, resp.Result, 1e-9)
+		if step.expectedCode != "" {
+			require.NotNil(t, resp.Error)
+			assert.Equal(t, step.expectedCode, resp.Error.Code)
+		} else {
+			assert.Nil(t, resp.Error)
+			require.NotNil(t, resp.CalculationMetadata)
+			assert.Equal(t, "session", resp.CalculationMetadata.CalculationMethod)
+		}
+	}
+
+	require.NoError(t, stream.CloseSend())
+	_, err = stream.Recv()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestServiceInteraction_SessionConstraints(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx,
+		"bufnet",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(bufDialer),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client := pb.NewAdditionServiceClient(conn)
+
+	stream, err := client.Session(ctx)
+	require.NoError(t, err)
+
+	minSum := 100.0
+	add := pb.SessionRequest_OPERATION_ADD
+
+	steps := []struct {
+		request          *pb.SessionRequest
+		expectedResult   float64
+		expectedClamped  int32
+		expectedDropped  int32
+		expectedWarnings []string
+		expectedCode     string
+	}{
+		{
+			request: &pb.SessionRequest{
+				Operation: add,
+				Value:     2.4,
+				Constraints: &pb.AddRequest_Constraints{
+					IntegerOnly:       true,
+					NonNegative:       true,
+					Unique:            true,
+					MinSum:            &minSum,
+					IntegerOnlyAction: pb.ConstraintAction_CONSTRAINT_ACTION_CLAMP,
+					NonNegativeAction: pb.ConstraintAction_CONSTRAINT_ACTION_DROP,
+					MinSumAction:      pb.ConstraintAction_CONSTRAINT_ACTION_WARN,
+				},
+			},
+			expectedResult:  2,
+			expectedClamped: 1,
+		},
+		{
+			request:         &pb.SessionRequest{Operation: add, Value: -3},
+			expectedResult:  2,
+			expectedClamped: 1,
+			expectedDropped: 1,
+		},
+		{
+			request:        &pb.SessionRequest{Operation: add, Value: 2},
+			expectedResult: 2,
+			expectedCode:   "DUPLICATE_VALUE",
+		},
+		{
+			request:          &pb.SessionRequest{Operation: pb.SessionRequest_OPERATION_TOTAL},
+			expectedResult:   2,
+			expectedClamped:  1,
+			expectedDropped:  1,
+			expectedWarnings: []string{"SUM_TOO_LOW"},
+		},
+		{
+			request: &pb.SessionRequest{
+				Operation:   add,
+				Value:       1,
+				Constraints: &pb.AddRequest_Constraints{MinValueAction: pb.ConstraintAction_CONSTRAINT_ACTION_WARN},
+			},
+			expectedResult: 2,
+			expectedCode:   "INVALID_CONSTRAINT_ACTION",
+		},
+		{
+			request: &pb.SessionRequest{Operation: pb.SessionRequest_OPERATION_CLEAR},
+		},
+		{
+			request:        &pb.SessionRequest{Operation: add, Value: 2},
+			expectedResult: 2,
+		},
+	}
+
+	for i, step := range steps {
+		require.NoError(t, stream.Send(step.request), "step %d", i+1)
+
+		resp, err := stream.Recv()
+		require.NoError(t, err, "step %d", i+1)
+
+		assert.InDelta(t, step.expectedResult, resp.Result, 1e-9, "step %d", i+1)
+		if step.expectedCode != "" {
+			require.NotNil(t, resp.Error, "step %d", i+1)
+			assert.Equal(t, step.expectedCode, resp.Error.Code, "step %d", i+1)
+			continue
+		}
+		require.Nil(t, resp.Error, "step %d", i+1)
+		assert.Equal(t, step.expectedClamped, resp.CalculationMetadata.ClampedCount, "step %d", i+1)
+		assert.Equal(t, step.expectedDropped, resp.CalculationMetadata.DroppedCount, "step %d", i+1)
+		var warnings []string
+		for _, warning := range resp.CalculationMetadata.Warnings {
+			warnings = append(warnings, warning.Code)
+		}
+		assert.Equal(t, step.expectedWarnings, warnings, "step %d", i+1)
+	}
+
+	require.NoError(t, stream.CloseSend())
+}
+
+// statusResponse extracts the response a failed call carries in its status
+func statusResponse[T any](t *testing.T, err error) T {
+	t.Helper()
+	for _, detail := range status.Convert(err).Details() {
+		if response, ok := detail.(T); ok {
+			return response
+		}
+	}
+	var zero T
+	require.Fail(t, "status carries no response", "error: %v", err)
+	return zero
+}
+
+func TestServiceInteraction_EvaluateParseError(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx,
+		"bufnet",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(bufDialer),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client := pb.NewAdditionServiceClient(conn)
+
+	// The parse errors travel in the status of the failed call
+	resp, err := client.Evaluate(ctx, &pb.EvaluateRequest{Expression: "1 + "})
+	require.Error(t, err)
+	assert.Nil(t, resp)
+
+	detailed := statusResponse[*pb.EvaluateResponse](t, err)
+	require.NotNil(t, detailed.Error)
+	assert.Equal(t, "PARSE_ERROR", detailed.Error.Code)
+	require.Len(t, detailed.ParseErrors, 1)
+	require.NotNil(t, detailed.ParseErrors[0].Position)
+	assert.Equal(t, int32(4), *detailed.ParseErrors[0].Position)
+
+	// The web handler passes them on to its clients
+	handler := webhandler.NewWebHandler(client, logging.NewLogger(logging.LogConfig{
+		ServiceName: "web-handler",
+	}))
+	body, err := json.Marshal(webhandler.EvaluateRequest{Expression: "1 + "})
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	handler.EvaluateHandler(w, httptest.NewRequest(http.MethodPost, "/evaluate", bytes.NewBuffer(body)))
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	var evaluateResp webhandler.EvaluateResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&evaluateResp))
+	require.NotNil(t, evaluateResp.Error)
+	assert.Equal(t, "PARSE_ERROR", evaluateResp.Error.Code)
+	require.Len(t, evaluateResp.ParseErrors, 1)
+	require.NotNil(t, evaluateResp.ParseErrors[0].Position)
+	assert.Equal(t, int32(4), *evaluateResp.ParseErrors[0].Position)
+}
+
+func TestServiceInteraction_CollectAllViolations(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx,
+		"bufnet",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(bufDialer),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client := pb.NewAdditionServiceClient(conn)
+
+	// Every violation travels in the status of the failed call
+	maxValue := 100.0
+	resp, err := client.Add(ctx, &pb.AddRequest{
+		Numbers: []float64{150, 5, 200},
+		Constraints: &pb.AddRequest_Constraints{
+			MaxValue:       &maxValue,
+			ValidationMode: pb.ValidationMode_VALIDATION_MODE_COLLECT_ALL,
+		},
+	})
+	require.Error(t, err)
+	assert.Nil(t, resp)
+
+	detailed := statusResponse[*pb.AddResponse](t, err)
+	require.NotNil(t, detailed.Error)
+	assert.Equal(t, "CONSTRAINT_VIOLATIONS", detailed.Error.Code)
+	require.Len(t, detailed.Error.Violations, 2)
+	assert.Equal(t, int32(0), detailed.Error.Violations[0].Index)
+	assert.Equal(t, int32(2), detailed.Error.Violations[1].Index)
+
+	// The web handler passes them on to its clients
+	handler := webhandler.NewWebHandler(client, logging.NewLogger(logging.LogConfig{
+		ServiceName: "web-handler",
+	}))
+	body, err := json.Marshal(webhandler.AddRequest{
+		Numbers:        []float64{150, 5, 200},
+		MaxValue:       &maxValue,
+		ValidationMode: "collect_all",
+	})
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	handler.AddHandler(w, httptest.NewRequest(http.MethodPost, "/add", bytes.NewBuffer(body)))
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	var addResp webhandler.AddResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&addResp))
+	require.NotNil(t, addResp.Error)
+	assert.Equal(t, "CONSTRAINT_VIOLATIONS", addResp.Error.Code)
+	require.Len(t, addResp.Error.Violations, 2)
+	assert.Equal(t, "max_value", addResp.Error.Violations[1].Rule)
+}
+
+func TestServiceInteraction_StreamHistory(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx,
+		"bufnet",
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(bufDialer),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	client := pb.NewAdditionServiceClient(conn)
+
+	addStream, err := client.AddStream(ctx)
+	require.NoError(t, err)
+	require.NoError(t, addStream.Send(&pb.AddStreamRequest{RequestId: "integration-history-stream", Numbers: []float64{1, 2}}))
+	require.NoError(t, addStream.Send(&pb.AddStreamRequest{Numbers: []float64{3}}))
+	_, err = addStream.CloseAndRecv()
+	require.NoError(t, err)
+
+	progress, err := client.AddProgress(ctx, &pb.AddProgressRequest{
+		RequestId:          "integration-history-progress",
+		Numbers:            []float64{1, 2, 3},
+		ReportEveryNumbers: 1,
+	})
+	require.NoError(t, err)
+	for {
+		_, err := progress.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+	}
+
+	session, err := client.Session(ctx)
+	require.NoError(t, err)
+	require.NoError(t, session.Send(&pb.SessionRequest{
+		RequestId: "integration-history-session",
+		Operation: pb.SessionRequest_OPERATION_ADD,
+		Value:     3,
+	}))
+	_, err = session.Recv()
+	require.NoError(t, err)
+	require.NoError(t, session.CloseSend())
+
+	expected := []struct {
+		requestID string
+		operation string
+		processed int32
+	}{
+		{requestID: "integration-history-stream", operation: "add_stream", processed: 3},
+		{requestID: "integration-history-progress", operation: "add_progress", processed: 3},
+		{requestID: "integration-history-session", operation: "session", processed: 1},
+	}
+	for _, e := range expected {
+		resp, err := client.GetCalculation(ctx, &pb.GetCalculationRequest{RequestId: e.requestID})
+		require.NoError(t, err, e.requestID)
+		assert.Equal(t, e.operation, resp.Calculation.Operation)
+		assert.Equal(t, e.processed, resp.Calculation.NumbersProcessed)
+		assert.False(t, resp.Calculation.Truncated)
+	}
+}
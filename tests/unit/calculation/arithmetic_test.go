@@ -0,0 +1,114 @@
+package calculation
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/service"
+)
+
+func TestAdditionService_Subtract(t *testing.T) {
+	calculationService := service.NewAdditionService()
+
+	resp, err := calculationService.Subtract(context.Background(), &v1.SubtractRequest{
+		Numbers:   []float64{10.0, 2.5, 1.5},
+		RequestId: "subtract-test",
+	})
+
+	require.NoError(t, err)
+	assert.InDelta(t, 6.0, resp.Result, 1e-9)
+	assert.Equal(t, "subtract-test", resp.RequestId)
+	require.NotNil(t, resp.CalculationMetadata)
+	assert.Equal(t, int32(3), resp.CalculationMetadata.NumbersProcessed)
+	assert.Equal(t, "simple_subtraction", resp.CalculationMetadata.CalculationMethod)
+}
+
+func TestAdditionService_Multiply(t *testing.T) {
+	calculationService := service.NewAdditionService()
+
+	resp, err := calculationService.Multiply(context.Background(), &v1.MultiplyRequest{
+		Numbers:   []float64{2.0, -3.0, 0.5},
+		RequestId: "multiply-test",
+	})
+
+	require.NoError(t, err)
+	assert.InDelta(t, -3.0, resp.Result, 1e-9)
+	require.NotNil(t, resp.CalculationMetadata)
+	assert.Equal(t, "simple_multiplication", resp.CalculationMetadata.CalculationMethod)
+
+	// Multiplying large numbers overflows
+	resp, err = calculationService.Multiply(context.Background(), &v1.MultiplyRequest{
+		Numbers: []float64{math.MaxFloat64, 2.0},
+	})
+
+	require.Error(t, err)
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, "OVERFLOW", resp.Error.Code)
+}
+
+func TestAdditionService_Divide(t *testing.T) {
+	calculationService := service.NewAdditionService()
+
+	testCases := []struct {
+		name           string
+		request        *v1.DivideRequest
+		expectedResult float64
+		expectedCode   string
+	}{
+		{
+			name: "Basic Division",
+			request: &v1.DivideRequest{
+				Numbers: []float64{12.0, 3.0, 2.0},
+			},
+			expectedResult: 2.0,
+		},
+		{
+			name: "Division By Zero",
+			request: &v1.DivideRequest{
+				Numbers: []float64{1.0, 2.0, 0.0},
+			},
+			expectedCode: "DIVISION_BY_ZERO",
+		},
+		{
+			name: "Empty Input",
+			request: &v1.DivideRequest{
+				Numbers: []float64{},
+			},
+			expectedCode: "NO_NUMBERS",
+		},
+		{
+			name: "Constraints - Max Value",
+			request: &v1.DivideRequest{
+				Numbers: []float64{100.0, 2.0},
+				Constraints: &v1.AddRequest_Constraints{
+					MaxValue: floatPtr(10.0),
+				},
+			},
+			expectedCode: "VALUE_TOO_HIGH",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := calculationService.Divide(context.Background(), tc.request)
+
+			if tc.expectedCode != "" {
+				require.Error(t, err)
+				require.NotNil(t, resp.Error)
+				assert.Equal(t, tc.expectedCode, resp.Error.Code)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.InDelta(t, tc.expectedResult, resp.Result, 1e-9)
+			assert.NotEmpty(t, resp.RequestId)
+			require.NotNil(t, resp.CalculationMetadata)
+			assert.Equal(t, "simple_division", resp.CalculationMetadata.CalculationMethod)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package calculation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/service"
+)
+
+func TestAdditionService_BatchAdd(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	resp, err := additionService.BatchAdd(context.Background(), &v1.BatchAddRequest{
+		RequestId: "batch-test",
+		Requests: []*v1.AddRequest{
+			{Numbers: []float64{1.0, 2.0}, RequestId: "item-1"},
+			{Numbers: []float64{}, RequestId: "item-2"},
+			{
+				Numbers:     []float64{5.0, 50.0},
+				RequestId:   "item-3",
+				Constraints: &v1.AddRequest_Constraints{MaxValue: floatPtr(10.0)},
+			},
+			{Numbers: []float64{-1.5, 0.5}, RequestId: "item-4"},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "batch-test", resp.RequestId)
+	assert.Nil(t, resp.Error)
+	assert.Equal(t, int32(2), resp.FailedCount)
+	require.Len(t, resp.Responses, 4)
+
+	assert.Equal(t, "item-1", resp.Responses[0].RequestId)
+	assert.Equal(t, 3.0, resp.Responses[0].Result)
+	assert.Nil(t, resp.Responses[0].Error)
+
+	require.NotNil(t, resp.Responses[1].Error)
+	assert.Equal(t, "NO_NUMBERS", resp.Responses[1].Error.Code)
+
+	require.NotNil(t, resp.Responses[2].Error)
+	assert.Equal(t, "VALUE_TOO_HIGH", resp.Responses[2].Error.Code)
+
+	assert.Equal(t, "item-4", resp.Responses[3].RequestId)
+	assert.Equal(t, -1.0, resp.Responses[3].Result)
+}
+
+func TestAdditionService_BatchAddEmpty(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	resp, err := additionService.BatchAdd(context.Background(), &v1.BatchAddRequest{})
+
+	require.Error(t, err)
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, "NO_REQUESTS", resp.Error.Code)
+	assert.NotEmpty(t, resp.RequestId)
+}
@@ -0,0 +1,172 @@
+package calculation
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/service"
+)
+
+func TestAdditionService_AddComplex(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	testCases := []struct {
+		name           string
+		request        *v1.AddRequest
+		expectedResult *v1.Complex
+		expectedMethod string
+	}{
+		{
+			name: "Basic Complex Addition",
+			request: &v1.AddRequest{
+				ComplexNumbers: []*v1.Complex{
+					{Re: 1, Im: 2},
+					{Re: 3, Im: -5},
+					{Re: -0.5},
+				},
+			},
+			expectedResult: &v1.Complex{Re: 3.5, Im: -3},
+			expectedMethod: "simple_addition",
+		},
+		{
+			name: "Compensated Summation",
+			request: &v1.AddRequest{
+				ComplexNumbers: []*v1.Complex{
+					{Re: 1e16, Im: 1},
+					{Re: 1, Im: 1e16},
+					{Re: -1e16, Im: -1e16},
+				},
+				Algorithm: v1.SummationAlgorithm_SUMMATION_ALGORITHM_NEUMAIER,
+			},
+			expectedResult: &v1.Complex{Re: 1, Im: 1},
+			expectedMethod: "neumaier_summation",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := additionService.Add(context.Background(), tc.request)
+
+			require.NoError(t, err)
+			require.NotNil(t, resp.ComplexResult)
+			assert.Equal(t, tc.expectedResult.Re, resp.ComplexResult.Re)
+			assert.Equal(t, tc.expectedResult.Im, resp.ComplexResult.Im)
+			require.NotNil(t, resp.CalculationMetadata)
+			assert.Equal(t, tc.expectedMethod, resp.CalculationMetadata.CalculationMethod)
+			assert.Equal(t, int32(len(tc.request.ComplexNumbers)), resp.CalculationMetadata.NumbersProcessed)
+		})
+	}
+}
+
+func TestAdditionService_AddComplexErrors(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	testCases := []struct {
+		name         string
+		request      *v1.AddRequest
+		expectedCode string
+	}{
+		{
+			name: "Imaginary Overflow",
+			request: &v1.AddRequest{
+				ComplexNumbers: []*v1.Complex{
+					{Re: 1, Im: math.MaxFloat64},
+					{Re: 1, Im: math.MaxFloat64},
+				},
+			},
+			expectedCode: "OVERFLOW",
+		},
+		{
+			name: "NaN Part",
+			request: &v1.AddRequest{
+				ComplexNumbers: []*v1.Complex{
+					{Re: math.NaN(), Im: 1},
+					{Re: 1, Im: 1},
+				},
+			},
+			expectedCode: "NAN_INPUT",
+		},
+		{
+			name: "Infinite Part",
+			request: &v1.AddRequest{
+				ComplexNumbers: []*v1.Complex{
+					{Re: 1, Im: math.Inf(1)},
+				},
+			},
+			expectedCode: "INFINITE_INPUT",
+		},
+		{
+			name: "Finite Only",
+			request: &v1.AddRequest{
+				ComplexNumbers: []*v1.Complex{{Re: 1, Im: math.Inf(1)}},
+				Constraints: &v1.AddRequest_Constraints{
+					FiniteOnly:     true,
+					InfinityPolicy: v1.NonFinitePolicy_NON_FINITE_POLICY_PROPAGATE,
+				},
+			},
+			expectedCode: "NOT_FINITE",
+		},
+		{
+			name: "Every Operand Skipped",
+			request: &v1.AddRequest{
+				ComplexNumbers: []*v1.Complex{{Re: math.NaN()}},
+				Constraints:    &v1.AddRequest_Constraints{NanPolicy: v1.NonFinitePolicy_NON_FINITE_POLICY_SKIP},
+			},
+			expectedCode: "NO_NUMBERS",
+		},
+		{
+			name: "Part Above Maximum",
+			request: &v1.AddRequest{
+				ComplexNumbers: []*v1.Complex{{Re: 1, Im: 20}},
+				Constraints:    &v1.AddRequest_Constraints{MaxValue: floatPtr(10)},
+			},
+			expectedCode: "VALUE_TOO_HIGH",
+		},
+		{
+			name: "Too Many Numbers",
+			request: &v1.AddRequest{
+				ComplexNumbers: []*v1.Complex{{Re: 1}, {Re: 2}, {Re: 3}},
+				Constraints:    &v1.AddRequest_Constraints{MaxNumbers: intPtr(2)},
+			},
+			expectedCode: "CONSTRAINT_VIOLATION",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := additionService.Add(context.Background(), tc.request)
+
+			require.Error(t, err)
+			require.NotNil(t, resp.Error)
+			assert.Equal(t, tc.expectedCode, resp.Error.Code)
+		})
+	}
+}
+
+func TestAdditionService_AddComplexNonFinitePolicies(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	// Skipping drops the whole operand
+	resp, err := additionService.Add(context.Background(), &v1.AddRequest{
+		ComplexNumbers: []*v1.Complex{{Re: 1, Im: math.NaN()}, {Re: 2, Im: 3}},
+		Constraints:    &v1.AddRequest_Constraints{NanPolicy: v1.NonFinitePolicy_NON_FINITE_POLICY_SKIP},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2.0, resp.ComplexResult.Re)
+	assert.Equal(t, 3.0, resp.ComplexResult.Im)
+	assert.Equal(t, int32(1), resp.CalculationMetadata.NumbersProcessed)
+
+	// Propagated infinities may cancel out into NaN
+	resp, err = additionService.Add(context.Background(), &v1.AddRequest{
+		ComplexNumbers: []*v1.Complex{{Re: math.Inf(1), Im: 1}, {Re: math.Inf(-1), Im: math.Inf(1)}},
+		Constraints:    &v1.AddRequest_Constraints{InfinityPolicy: v1.NonFinitePolicy_NON_FINITE_POLICY_PROPAGATE},
+	})
+	require.NoError(t, err)
+	assert.True(t, math.IsNaN(resp.ComplexResult.Re))
+	assert.True(t, math.IsInf(resp.ComplexResult.Im, 1))
+}
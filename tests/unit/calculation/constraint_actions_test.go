@@ -0,0 +1,328 @@
+package calculation
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/service"
+)
+
+func TestAdditionService_ConstraintActions(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	clamp := v1.ConstraintAction_CONSTRAINT_ACTION_CLAMP
+	drop := v1.ConstraintAction_CONSTRAINT_ACTION_DROP
+	warn := v1.ConstraintAction_CONSTRAINT_ACTION_WARN
+
+	testCases := []struct {
+		name             string
+		request          *v1.AddRequest
+		expectedResult   float64
+		expectedClamped  int32
+		expectedDropped  int32
+		expectedWarnings []string
+		expectedCode     string
+	}{
+		{
+			name: "Clamp To Bounds",
+			request: &v1.AddRequest{
+				Numbers: []float64{-5, 5, 50},
+				Constraints: &v1.AddRequest_Constraints{
+					MinValue:       floatPtr(0),
+					MaxValue:       floatPtr(10),
+					MinValueAction: clamp,
+					MaxValueAction: clamp,
+				},
+			},
+			expectedResult:  15,
+			expectedClamped: 2,
+		},
+		{
+			name: "Drop Out Of Range",
+			request: &v1.AddRequest{
+				Numbers: []float64{-5, 5, 50},
+				Constraints: &v1.AddRequest_Constraints{
+					MinValue:       floatPtr(0),
+					MaxValue:       floatPtr(10),
+					MinValueAction: drop,
+					MaxValueAction: drop,
+				},
+			},
+			expectedResult:  5,
+			expectedDropped: 2,
+		},
+		{
+			name: "Reject Keeps Default Behaviour",
+			request: &v1.AddRequest{
+				Numbers: []float64{-5, 5},
+				Constraints: &v1.AddRequest_Constraints{
+					MinValue:       floatPtr(0),
+					MinValueAction: v1.ConstraintAction_CONSTRAINT_ACTION_REJECT,
+				},
+			},
+			expectedCode: "VALUE_TOO_LOW",
+		},
+		{
+			name: "Clamp To Nearest Integer",
+			request: &v1.AddRequest{
+				Numbers: []float64{1.4, 2.6, 3},
+				Constraints: &v1.AddRequest_Constraints{
+					IntegerOnly:       true,
+					IntegerOnlyAction: clamp,
+				},
+			},
+			expectedResult:  7,
+			expectedClamped: 2,
+		},
+		{
+			name: "Clamp Decimal Places",
+			request: &v1.AddRequest{
+				Numbers: []float64{1.256, 0.5},
+				Constraints: &v1.AddRequest_Constraints{
+					MaxDecimalPlaces:       intPtr(2),
+					MaxDecimalPlacesAction: clamp,
+				},
+			},
+			expectedResult:  1.76,
+			expectedClamped: 1,
+		},
+		{
+			name: "Clamp Negative To Zero",
+			request: &v1.AddRequest{
+				Numbers: []float64{-3, 4},
+				Constraints: &v1.AddRequest_Constraints{
+					NonNegative:       true,
+					NonNegativeAction: clamp,
+				},
+			},
+			expectedResult:  4,
+			expectedClamped: 1,
+		},
+		{
+			name: "Drop Duplicates",
+			request: &v1.AddRequest{
+				Numbers: []float64{1, 2, 1, 3},
+				Constraints: &v1.AddRequest_Constraints{
+					Unique:       true,
+					UniqueAction: drop,
+				},
+			},
+			expectedResult:  6,
+			expectedDropped: 1,
+		},
+		{
+			name: "Drop Numbers Beyond Maximum",
+			request: &v1.AddRequest{
+				Numbers: []float64{1, 2, 3, 4},
+				Constraints: &v1.AddRequest_Constraints{
+					MaxNumbers:       intPtr(2),
+					MaxNumbersAction: drop,
+				},
+			},
+			expectedResult:  3,
+			expectedDropped: 2,
+		},
+		{
+			name: "Clamped Values Checked For Duplicates",
+			request: &v1.AddRequest{
+				Numbers: []float64{10, 20, 5},
+				Constraints: &v1.AddRequest_Constraints{
+					MaxValue:       floatPtr(10),
+					MaxValueAction: clamp,
+					Unique:         true,
+					UniqueAction:   drop,
+				},
+			},
+			expectedResult:  15,
+			expectedClamped: 1,
+			expectedDropped: 1,
+		},
+		{
+			name: "Every Number Dropped",
+			request: &v1.AddRequest{
+				Numbers: []float64{-1, -2},
+				Constraints: &v1.AddRequest_Constraints{
+					NonNegative:       true,
+					NonNegativeAction: drop,
+				},
+			},
+			expectedCode: "NO_NUMBERS",
+		},
+		{
+			name: "Conflicting Bounds Rejected",
+			request: &v1.AddRequest{
+				Numbers: []float64{5},
+				Constraints: &v1.AddRequest_Constraints{
+					MinValue:       floatPtr(10),
+					MaxValue:       floatPtr(0),
+					MinValueAction: clamp,
+					MaxValueAction: clamp,
+				},
+			},
+			expectedCode: "VALUE_TOO_LOW",
+		},
+		{
+			name: "Clamp Without Bound",
+			request: &v1.AddRequest{
+				Numbers: []float64{1, 1},
+				Constraints: &v1.AddRequest_Constraints{
+					Unique:       true,
+					UniqueAction: clamp,
+				},
+			},
+			expectedCode: "INVALID_CONSTRAINT_ACTION",
+		},
+		{
+			name: "Unknown Action",
+			request: &v1.AddRequest{
+				Numbers: []float64{1},
+				Constraints: &v1.AddRequest_Constraints{
+					MinValueAction: v1.ConstraintAction(42),
+				},
+			},
+			expectedCode: "INVALID_CONSTRAINT_ACTION",
+		},
+		{
+			name: "Warn Below Min Sum",
+			request: &v1.AddRequest{
+				Numbers: []float64{1, 2},
+				Constraints: &v1.AddRequest_Constraints{
+					MinSum:       floatPtr(10),
+					MinSumAction: warn,
+				},
+			},
+			expectedResult:   3,
+			expectedWarnings: []string{"SUM_TOO_LOW"},
+		},
+		{
+			name: "Warn Above Max Sum And Too Few Numbers",
+			request: &v1.AddRequest{
+				Numbers: []float64{10, 20},
+				Constraints: &v1.AddRequest_Constraints{
+					MaxSum:           floatPtr(5),
+					MinNumbers:       intPtr(3),
+					MaxSumAction:     warn,
+					MinNumbersAction: warn,
+				},
+			},
+			expectedResult:   30,
+			expectedWarnings: []string{"TOO_FEW_NUMBERS", "SUM_TOO_HIGH"},
+		},
+		{
+			name: "Reject Above Max Sum",
+			request: &v1.AddRequest{
+				Numbers: []float64{10, 20},
+				Constraints: &v1.AddRequest_Constraints{
+					MaxSum:       floatPtr(5),
+					MaxSumAction: v1.ConstraintAction_CONSTRAINT_ACTION_REJECT,
+				},
+			},
+			expectedCode: "SUM_TOO_HIGH",
+		},
+		{
+			name: "Drop Non-Finite",
+			request: &v1.AddRequest{
+				Numbers: []float64{1, math.NaN(), math.Inf(1), 2},
+				Constraints: &v1.AddRequest_Constraints{
+					FiniteOnly:       true,
+					FiniteOnlyAction: drop,
+				},
+			},
+			expectedResult:  3,
+			expectedDropped: 2,
+		},
+		{
+			name: "Warn On Per-Number Constraint",
+			request: &v1.AddRequest{
+				Numbers: []float64{1},
+				Constraints: &v1.AddRequest_Constraints{
+					MinValue:       floatPtr(5),
+					MinValueAction: warn,
+				},
+			},
+			expectedCode: "INVALID_CONSTRAINT_ACTION",
+		},
+		{
+			name: "Warn On Non-Finite",
+			request: &v1.AddRequest{
+				Numbers: []float64{1},
+				Constraints: &v1.AddRequest_Constraints{
+					FiniteOnly:       true,
+					FiniteOnlyAction: warn,
+				},
+			},
+			expectedCode: "INVALID_CONSTRAINT_ACTION",
+		},
+		{
+			name: "Clamp Sum",
+			request: &v1.AddRequest{
+				Numbers: []float64{1},
+				Constraints: &v1.AddRequest_Constraints{
+					MinSum:       floatPtr(5),
+					MinSumAction: clamp,
+				},
+			},
+			expectedCode: "INVALID_CONSTRAINT_ACTION",
+		},
+		{
+			name: "Drop Too Few Numbers",
+			request: &v1.AddRequest{
+				Numbers: []float64{1},
+				Constraints: &v1.AddRequest_Constraints{
+					MinNumbers:       intPtr(2),
+					MinNumbersAction: drop,
+				},
+			},
+			expectedCode: "INVALID_CONSTRAINT_ACTION",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := additionService.Add(context.Background(), tc.request)
+
+			if tc.expectedCode != "" {
+				require.Error(t, err)
+				require.NotNil(t, resp.Error)
+				assert.Equal(t, tc.expectedCode, resp.Error.Code)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.InDelta(t, tc.expectedResult, resp.Result, 1e-9)
+			require.NotNil(t, resp.CalculationMetadata)
+			assert.Equal(t, tc.expectedClamped, resp.CalculationMetadata.ClampedCount)
+			assert.Equal(t, tc.expectedDropped, resp.CalculationMetadata.DroppedCount)
+
+			var warnings []string
+			for _, warning := range resp.CalculationMetadata.Warnings {
+				assert.Equal(t, v1.AddResponse_ErrorInfo_SEVERITY_WARNING, warning.Severity)
+				warnings = append(warnings, warning.Code)
+			}
+			assert.Equal(t, tc.expectedWarnings, warnings)
+		})
+	}
+}
+
+func TestAdditionService_ConstraintActionsWithUnits(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	resp, err := additionService.Add(context.Background(), &v1.AddRequest{
+		Numbers: []float64{500, 2, 30},
+		Units:   []string{"cm", "m", "cm"},
+		Constraints: &v1.AddRequest_Constraints{
+			MaxValue:       floatPtr(100),
+			MaxValueAction: v1.ConstraintAction_CONSTRAINT_ACTION_DROP,
+		},
+	})
+
+	require.NoError(t, err)
+	assert.InDelta(t, 230, resp.Result, 1e-9)
+	assert.Equal(t, "cm", resp.GetUnit())
+	assert.Equal(t, int32(1), resp.CalculationMetadata.DroppedCount)
+}
@@ -0,0 +1,318 @@
+package calculation
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/service"
+)
+
+func TestAdditionService_Constraints(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	testCases := []struct {
+		name           string
+		numbers        []float64
+		constraints    *v1.AddRequest_Constraints
+		expectedResult float64
+		expectedCode   string
+	}{
+		{
+			name:           "Sum Within Range",
+			numbers:        []float64{10, 20},
+			constraints:    &v1.AddRequest_Constraints{MinSum: floatPtr(0), MaxSum: floatPtr(50)},
+			expectedResult: 30,
+		},
+		{
+			name:         "Sum Below Minimum",
+			numbers:      []float64{10, -20},
+			constraints:  &v1.AddRequest_Constraints{MinSum: floatPtr(0)},
+			expectedCode: "SUM_TOO_LOW",
+		},
+		{
+			name:         "Sum Above Maximum",
+			numbers:      []float64{30, 40},
+			constraints:  &v1.AddRequest_Constraints{MaxSum: floatPtr(50)},
+			expectedCode: "SUM_TOO_HIGH",
+		},
+		{
+			name:           "Integers Only",
+			numbers:        []float64{1, -2, 3},
+			constraints:    &v1.AddRequest_Constraints{IntegerOnly: true},
+			expectedResult: 2,
+		},
+		{
+			name:         "Fraction Rejected By Integer Only",
+			numbers:      []float64{1, 2.5},
+			constraints:  &v1.AddRequest_Constraints{IntegerOnly: true},
+			expectedCode: "NOT_INTEGER",
+		},
+		{
+			name:           "Decimal Places Within Limit",
+			numbers:        []float64{1.25, 0.1},
+			constraints:    &v1.AddRequest_Constraints{MaxDecimalPlaces: intPtr(2)},
+			expectedResult: 1.35,
+		},
+		{
+			name:         "Too Many Decimal Places",
+			numbers:      []float64{1.25, 0.125},
+			constraints:  &v1.AddRequest_Constraints{MaxDecimalPlaces: intPtr(2)},
+			expectedCode: "TOO_MANY_DECIMALS",
+		},
+		{
+			name:    "Finite Only Overrides Policy",
+			numbers: []float64{1, math.Inf(1)},
+			constraints: &v1.AddRequest_Constraints{
+				FiniteOnly:     true,
+				InfinityPolicy: v1.NonFinitePolicy_NON_FINITE_POLICY_PROPAGATE,
+			},
+			expectedCode: "NOT_FINITE",
+		},
+		{
+			name:         "Negative Number Rejected",
+			numbers:      []float64{1, -0.5},
+			constraints:  &v1.AddRequest_Constraints{NonNegative: true},
+			expectedCode: "NEGATIVE_VALUE",
+		},
+		{
+			name:         "Too Few Numbers",
+			numbers:      []float64{1, 2},
+			constraints:  &v1.AddRequest_Constraints{MinNumbers: intPtr(3)},
+			expectedCode: "TOO_FEW_NUMBERS",
+		},
+		{
+			name:           "Unique Numbers",
+			numbers:        []float64{1, 2, 3},
+			constraints:    &v1.AddRequest_Constraints{Unique: true},
+			expectedResult: 6,
+		},
+		{
+			name:         "Duplicate Number Rejected",
+			numbers:      []float64{1, 2, 1},
+			constraints:  &v1.AddRequest_Constraints{Unique: true},
+			expectedCode: "DUPLICATE_VALUE",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := additionService.Add(context.Background(), &v1.AddRequest{
+				Numbers:     tc.numbers,
+				Constraints: tc.constraints,
+			})
+
+			if tc.expectedCode != "" {
+				require.Error(t, err)
+				require.NotNil(t, resp.Error)
+				assert.Equal(t, tc.expectedCode, resp.Error.Code)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.InDelta(t, tc.expectedResult, resp.Result, 1e-9)
+		})
+	}
+}
+
+func TestAdditionService_CollectAllRicherConstraints(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	resp, err := additionService.Add(context.Background(), &v1.AddRequest{
+		Numbers: []float64{2, -1, 2.5, 2},
+		Constraints: &v1.AddRequest_Constraints{
+			IntegerOnly:    true,
+			NonNegative:    true,
+			Unique:         true,
+			MinNumbers:     intPtr(5),
+			ValidationMode: v1.ValidationMode_VALIDATION_MODE_COLLECT_ALL,
+		},
+	})
+
+	require.Error(t, err)
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, "CONSTRAINT_VIOLATIONS", resp.Error.Code)
+
+	expected := []struct {
+		index int32
+		rule  string
+	}{
+		{index: -1, rule: "min_numbers"},
+		{index: 1, rule: "non_negative"},
+		{index: 2, rule: "integer_only"},
+		{index: 3, rule: "unique"},
+	}
+	require.Len(t, resp.Error.Violations, len(expected))
+	for i, violation := range resp.Error.Violations {
+		assert.Equal(t, expected[i].index, violation.Index)
+		assert.Equal(t, expected[i].rule, violation.Rule)
+	}
+}
+
+func TestAdditionService_ExactModeConstraints(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	clamp := v1.ConstraintAction_CONSTRAINT_ACTION_CLAMP
+	drop := v1.ConstraintAction_CONSTRAINT_ACTION_DROP
+	warn := v1.ConstraintAction_CONSTRAINT_ACTION_WARN
+
+	testCases := []struct {
+		name             string
+		request          *v1.AddRequest
+		expectedResult   float64
+		expectedClamped  int32
+		expectedDropped  int32
+		expectedWarnings []string
+		expectedCode     string
+	}{
+		{
+			name: "Decimal Non-Negative",
+			request: &v1.AddRequest{
+				DecimalNumbers: []string{"-1.5", "-1.5"},
+				Constraints:    &v1.AddRequest_Constraints{NonNegative: true, Unique: true},
+			},
+			expectedCode: "NEGATIVE_VALUE",
+		},
+		{
+			name: "Decimal Unique",
+			request: &v1.AddRequest{
+				DecimalNumbers: []string{"1.50", "1.5"},
+				Constraints:    &v1.AddRequest_Constraints{Unique: true},
+			},
+			expectedCode: "DUPLICATE_VALUE",
+		},
+		{
+			name: "Decimal Collect All",
+			request: &v1.AddRequest{
+				DecimalNumbers: []string{"-1.5", "2.25", "-1.5"},
+				Constraints: &v1.AddRequest_Constraints{
+					NonNegative:    true,
+					IntegerOnly:    true,
+					ValidationMode: v1.ValidationMode_VALIDATION_MODE_COLLECT_ALL,
+				},
+			},
+			expectedCode: "CONSTRAINT_VIOLATIONS",
+		},
+		{
+			name: "Decimal Min Sum",
+			request: &v1.AddRequest{
+				DecimalNumbers: []string{"5", "6"},
+				Constraints:    &v1.AddRequest_Constraints{MinSum: floatPtr(100)},
+			},
+			expectedCode: "SUM_TOO_LOW",
+		},
+		{
+			name: "Decimal Min Sum Warning",
+			request: &v1.AddRequest{
+				DecimalNumbers: []string{"5", "6"},
+				Constraints:    &v1.AddRequest_Constraints{MinSum: floatPtr(100), MinSumAction: warn},
+			},
+			expectedResult:   11,
+			expectedWarnings: []string{"SUM_TOO_LOW"},
+		},
+		{
+			name: "Decimal Clamp And Drop",
+			request: &v1.AddRequest{
+				DecimalNumbers: []string{"1.255", "50", "-3"},
+				Constraints: &v1.AddRequest_Constraints{
+					MaxValue:               floatPtr(10),
+					MaxDecimalPlaces:       intPtr(2),
+					NonNegative:            true,
+					MaxValueAction:         clamp,
+					MaxDecimalPlacesAction: clamp,
+					NonNegativeAction:      drop,
+				},
+			},
+			expectedResult:  11.26,
+			expectedClamped: 2,
+			expectedDropped: 1,
+		},
+		{
+			name: "Integer Min Numbers",
+			request: &v1.AddRequest{
+				IntegerNumbers: []int64{1, 2},
+				Constraints:    &v1.AddRequest_Constraints{MinNumbers: intPtr(3)},
+			},
+			expectedCode: "TOO_FEW_NUMBERS",
+		},
+		{
+			name: "Integer Clamp Stays Integral",
+			request: &v1.AddRequest{
+				IntegerNumbers: []int64{1, 7},
+				Constraints:    &v1.AddRequest_Constraints{MaxValue: floatPtr(2.5), MaxValueAction: clamp},
+			},
+			expectedResult:  3,
+			expectedClamped: 1,
+		},
+		{
+			name: "Integer Drop Extra",
+			request: &v1.AddRequest{
+				IntegerNumbers: []int64{1, 2, 3},
+				Constraints:    &v1.AddRequest_Constraints{MaxNumbers: intPtr(2), MaxNumbersAction: drop},
+			},
+			expectedResult:  3,
+			expectedDropped: 1,
+		},
+		{
+			name: "Fraction Integer Only",
+			request: &v1.AddRequest{
+				FractionNumbers: []*v1.Fraction{{Numerator: "1", Denominator: "3"}},
+				Constraints:     &v1.AddRequest_Constraints{IntegerOnly: true},
+			},
+			expectedCode: "NOT_INTEGER",
+		},
+		{
+			name: "Fraction Max Sum",
+			request: &v1.AddRequest{
+				FractionNumbers: []*v1.Fraction{{Numerator: "1", Denominator: "3"}, {Numerator: "1", Denominator: "3"}},
+				Constraints:     &v1.AddRequest_Constraints{MaxSum: floatPtr(0.5)},
+			},
+			expectedCode: "SUM_TOO_HIGH",
+		},
+		{
+			name: "Money Unique Per Currency",
+			request: &v1.AddRequest{
+				MoneyNumbers: []*v1.Money{{Currency: "EUR", Amount: "1.00"}, {Currency: "EUR", Amount: "1"}},
+				Constraints:  &v1.AddRequest_Constraints{Unique: true, UniqueAction: drop},
+			},
+			expectedResult:  1,
+			expectedDropped: 1,
+		},
+		{
+			name: "Invalid Action",
+			request: &v1.AddRequest{
+				DecimalNumbers: []string{"1"},
+				Constraints:    &v1.AddRequest_Constraints{MinValue: floatPtr(5), MinValueAction: warn},
+			},
+			expectedCode: "INVALID_CONSTRAINT_ACTION",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := additionService.Add(context.Background(), tc.request)
+
+			if tc.expectedCode != "" {
+				require.Error(t, err)
+				require.NotNil(t, resp.Error)
+				assert.Equal(t, tc.expectedCode, resp.Error.Code)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.InDelta(t, tc.expectedResult, resp.Result, 1e-9)
+			require.NotNil(t, resp.CalculationMetadata)
+			assert.Equal(t, tc.expectedClamped, resp.CalculationMetadata.ClampedCount)
+			assert.Equal(t, tc.expectedDropped, resp.CalculationMetadata.DroppedCount)
+			var warnings []string
+			for _, warning := range resp.CalculationMetadata.Warnings {
+				warnings = append(warnings, warning.Code)
+			}
+			assert.Equal(t, tc.expectedWarnings, warnings)
+		})
+	}
+}
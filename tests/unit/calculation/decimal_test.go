@@ -0,0 +1,198 @@
+package calculation
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/service"
+)
+
+func TestAdditionService_AddDecimal(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	testCases := []struct {
+		name           string
+		numbers        []string
+		options        *v1.AddRequest_DecimalOptions
+		expectedResult string
+	}{
+		{
+			name:           "Exact Sum",
+			numbers:        []string{"0.1", "0.2"},
+			expectedResult: "0.3",
+		},
+		{
+			name:           "Keeps Largest Scale",
+			numbers:        []string{"1.25", "-0.005", "3"},
+			expectedResult: "4.245",
+		},
+		{
+			name:           "Beyond Double Precision",
+			numbers:        []string{"9007199254740993", "0.000000000000000001"},
+			expectedResult: "9007199254740993.000000000000000001",
+		},
+		{
+			name:           "Fixed Scale Pads Zeros",
+			numbers:        []string{"1.5", "2"},
+			options:        &v1.AddRequest_DecimalOptions{Scale: intPtr(2)},
+			expectedResult: "3.50",
+		},
+		{
+			name:           "Half Even Rounding",
+			numbers:        []string{"0.125", "0.1"},
+			options:        &v1.AddRequest_DecimalOptions{Scale: intPtr(2)},
+			expectedResult: "0.22",
+		},
+		{
+			name:    "Half Up Rounding",
+			numbers: []string{"0.125", "0.1"},
+			options: &v1.AddRequest_DecimalOptions{
+				Scale:        intPtr(2),
+				RoundingMode: v1.RoundingMode_ROUNDING_MODE_HALF_UP,
+			},
+			expectedResult: "0.23",
+		},
+		{
+			name:    "Floor Rounding Negative",
+			numbers: []string{"-1.001"},
+			options: &v1.AddRequest_DecimalOptions{
+				Scale:        intPtr(0),
+				RoundingMode: v1.RoundingMode_ROUNDING_MODE_FLOOR,
+			},
+			expectedResult: "-2",
+		},
+		{
+			name:    "Down Rounding Negative",
+			numbers: []string{"-1.999"},
+			options: &v1.AddRequest_DecimalOptions{
+				Scale:        intPtr(1),
+				RoundingMode: v1.RoundingMode_ROUNDING_MODE_DOWN,
+			},
+			expectedResult: "-1.9",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := additionService.Add(context.Background(), &v1.AddRequest{
+				DecimalNumbers: tc.numbers,
+				DecimalOptions: tc.options,
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, resp.DecimalResult)
+			require.NotNil(t, resp.CalculationMetadata)
+			assert.Equal(t, "decimal", resp.CalculationMetadata.CalculationMethod)
+			assert.Equal(t, int32(len(tc.numbers)), resp.CalculationMetadata.NumbersProcessed)
+		})
+	}
+}
+
+func TestAdditionService_AddDecimalErrors(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	testCases := []struct {
+		name         string
+		request      *v1.AddRequest
+		expectedCode string
+	}{
+		{
+			name:         "Invalid Decimal",
+			request:      &v1.AddRequest{DecimalNumbers: []string{"1.0", "1/3"}},
+			expectedCode: "INVALID_DECIMAL",
+		},
+		{
+			name:         "Exponent Rejected",
+			request:      &v1.AddRequest{DecimalNumbers: []string{"1e10"}},
+			expectedCode: "INVALID_DECIMAL",
+		},
+		{
+			name:         "Mixed Operands",
+			request:      &v1.AddRequest{Numbers: []float64{1.0}, DecimalNumbers: []string{"1.0"}},
+			expectedCode: "MIXED_OPERANDS",
+		},
+		{
+			name: "Negative Scale",
+			request: &v1.AddRequest{
+				DecimalNumbers: []string{"1.0"},
+				DecimalOptions: &v1.AddRequest_DecimalOptions{Scale: intPtr(-1)},
+			},
+			expectedCode: "INVALID_SCALE",
+		},
+		{
+			name: "Constraints - Max Value",
+			request: &v1.AddRequest{
+				DecimalNumbers: []string{"10.000000000000000001"},
+				Constraints:    &v1.AddRequest_Constraints{MaxValue: floatPtr(10.0)},
+			},
+			expectedCode: "VALUE_TOO_HIGH",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := additionService.Add(context.Background(), tc.request)
+
+			require.Error(t, err)
+			require.NotNil(t, resp.Error)
+			assert.Equal(t, tc.expectedCode, resp.Error.Code)
+		})
+	}
+}
+
+func TestAdditionService_AddDecimalNonFiniteBounds(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	testCases := []struct {
+		name         string
+		constraints  *v1.AddRequest_Constraints
+		expectedCode string
+	}{
+		{
+			name:        "Infinite Max Value",
+			constraints: &v1.AddRequest_Constraints{MaxValue: floatPtr(math.Inf(1))},
+		},
+		{
+			name:        "Negative Infinite Min Value",
+			constraints: &v1.AddRequest_Constraints{MinValue: floatPtr(math.Inf(-1))},
+		},
+		{
+			name:         "Negative Infinite Max Value",
+			constraints:  &v1.AddRequest_Constraints{MaxValue: floatPtr(math.Inf(-1))},
+			expectedCode: "VALUE_TOO_HIGH",
+		},
+		{
+			name:         "Infinite Min Value",
+			constraints:  &v1.AddRequest_Constraints{MinValue: floatPtr(math.Inf(1))},
+			expectedCode: "VALUE_TOO_LOW",
+		},
+		{
+			name:         "NaN Max Value",
+			constraints:  &v1.AddRequest_Constraints{MaxValue: floatPtr(math.NaN())},
+			expectedCode: "INVALID_CONSTRAINT",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := additionService.Add(context.Background(), &v1.AddRequest{
+				DecimalNumbers: []string{"1.5", "-2.25"},
+				Constraints:    tc.constraints,
+			})
+
+			if tc.expectedCode == "" {
+				require.NoError(t, err)
+				assert.Equal(t, "-0.75", resp.DecimalResult)
+				return
+			}
+			require.Error(t, err)
+			require.NotNil(t, resp.Error)
+			assert.Equal(t, tc.expectedCode, resp.Error.Code)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package calculation
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/service"
+)
+
+func TestAdditionService_Describe(t *testing.T) {
+	calculationService := service.NewAdditionService()
+
+	resp, err := calculationService.Describe(context.Background(), &v1.DescribeRequest{
+		Numbers:     []float64{2, 4, 4, 4, 5, 5, 7, 9},
+		RequestId:   "describe-test",
+		Percentiles: []float64{0, 25, 90, 100},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "describe-test", resp.RequestId)
+	assert.InDelta(t, 40.0, resp.Sum, 1e-9)
+	assert.InDelta(t, 5.0, resp.Mean, 1e-9)
+	assert.InDelta(t, 4.0, resp.Variance, 1e-9)
+	assert.InDelta(t, 2.0, resp.StandardDeviation, 1e-9)
+	assert.InDelta(t, 32.0/7.0, resp.SampleVariance, 1e-9)
+	assert.InDelta(t, math.Sqrt(32.0/7.0), resp.SampleStandardDeviation, 1e-9)
+	assert.Equal(t, 2.0, resp.Min)
+	assert.Equal(t, 9.0, resp.Max)
+	assert.InDelta(t, 4.5, resp.Median, 1e-9)
+
+	expected := []struct {
+		percentile float64
+		value      float64
+	}{
+		{percentile: 0, value: 2},
+		{percentile: 25, value: 4},
+		{percentile: 90, value: 7.6},
+		{percentile: 100, value: 9},
+	}
+	require.Len(t, resp.Percentiles, len(expected))
+	for i, p := range resp.Percentiles {
+		assert.Equal(t, expected[i].percentile, p.Percentile)
+		assert.InDelta(t, expected[i].value, p.Value, 1e-9)
+	}
+
+	require.NotNil(t, resp.CalculationMetadata)
+	assert.Equal(t, int32(8), resp.CalculationMetadata.NumbersProcessed)
+	assert.Equal(t, "descriptive_statistics", resp.CalculationMetadata.CalculationMethod)
+}
+
+func TestAdditionService_DescribeErrors(t *testing.T) {
+	calculationService := service.NewAdditionService()
+
+	testCases := []struct {
+		name         string
+		request      *v1.DescribeRequest
+		expectedCode string
+	}{
+		{
+			name:         "Empty Input",
+			request:      &v1.DescribeRequest{},
+			expectedCode: "NO_NUMBERS",
+		},
+		{
+			name: "Invalid Percentile",
+			request: &v1.DescribeRequest{
+				Numbers:     []float64{1, 2, 3},
+				Percentiles: []float64{101},
+			},
+			expectedCode: "INVALID_PERCENTILE",
+		},
+		{
+			name: "Constraints - Max Numbers",
+			request: &v1.DescribeRequest{
+				Numbers:     []float64{1, 2, 3},
+				Constraints: &v1.AddRequest_Constraints{MaxNumbers: intPtr(2)},
+			},
+			expectedCode: "CONSTRAINT_VIOLATION",
+		},
+		{
+			name:         "NaN Input",
+			request:      &v1.DescribeRequest{Numbers: []float64{1, math.NaN()}},
+			expectedCode: "NAN_INPUT",
+		},
+		{
+			name:         "Infinite Input",
+			request:      &v1.DescribeRequest{Numbers: []float64{1, math.Inf(1)}},
+			expectedCode: "INFINITE_INPUT",
+		},
+		{
+			name:         "Variance Overflow",
+			request:      &v1.DescribeRequest{Numbers: []float64{math.MaxFloat64, -math.MaxFloat64}},
+			expectedCode: "OVERFLOW",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := calculationService.Describe(context.Background(), tc.request)
+
+			require.Error(t, err)
+			require.NotNil(t, resp.Error)
+			assert.Equal(t, tc.expectedCode, resp.Error.Code)
+		})
+	}
+}
+
+func TestAdditionService_DescribeNonFinitePolicies(t *testing.T) {
+	calculationService := service.NewAdditionService()
+
+	t.Run("Skip", func(t *testing.T) {
+		resp, err := calculationService.Describe(context.Background(), &v1.DescribeRequest{
+			Numbers:     []float64{1, math.NaN(), 3},
+			Constraints: &v1.AddRequest_Constraints{NanPolicy: v1.NonFinitePolicy_NON_FINITE_POLICY_SKIP},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 4.0, resp.Sum)
+		assert.Equal(t, 2.0, resp.Mean)
+		assert.Equal(t, int32(2), resp.CalculationMetadata.NumbersProcessed)
+	})
+
+	t.Run("Propagate", func(t *testing.T) {
+		resp, err := calculationService.Describe(context.Background(), &v1.DescribeRequest{
+			Numbers:     []float64{1, math.Inf(1), 3},
+			Constraints: &v1.AddRequest_Constraints{InfinityPolicy: v1.NonFinitePolicy_NON_FINITE_POLICY_PROPAGATE},
+		})
+
+		require.NoError(t, err)
+		assert.True(t, math.IsInf(resp.Sum, 1))
+		assert.True(t, math.IsInf(resp.Max, 1))
+	})
+}
@@ -0,0 +1,218 @@
+package calculation
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/service"
+)
+
+func TestAdditionService_AddVectors(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	resp, err := additionService.AddVectors(context.Background(), &v1.AddVectorsRequest{
+		RequestId: "vectors-test",
+		Vectors: []*v1.Vector{
+			{Values: []float64{1.0, 2.0, 3.0}},
+			{Values: []float64{0.5, -2.0, 10.0}},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "vectors-test", resp.RequestId)
+	require.NotNil(t, resp.Result)
+	assert.Equal(t, []float64{1.5, 0.0, 13.0}, resp.Result.Values)
+	require.NotNil(t, resp.CalculationMetadata)
+	assert.Equal(t, int32(2), resp.CalculationMetadata.NumbersProcessed)
+	assert.Equal(t, "elementwise_addition", resp.CalculationMetadata.CalculationMethod)
+}
+
+func TestAdditionService_AddMatrices(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	resp, err := additionService.AddMatrices(context.Background(), &v1.AddMatricesRequest{
+		Matrices: []*v1.Matrix{
+			{Rows: 2, Columns: 3, Values: []float64{1, 2, 3, 4, 5, 6}},
+			{Rows: 2, Columns: 3, Values: []float64{6, 5, 4, 3, 2, 1}},
+			{Rows: 2, Columns: 3, Values: []float64{1, 1, 1, 1, 1, 1}},
+		},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, resp.Result)
+	assert.Equal(t, int32(2), resp.Result.Rows)
+	assert.Equal(t, int32(3), resp.Result.Columns)
+	assert.Equal(t, []float64{8, 8, 8, 8, 8, 8}, resp.Result.Values)
+}
+
+func TestAdditionService_ElementwiseErrors(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	testCases := []struct {
+		name         string
+		call         func() (*v1.AddResponse_ErrorInfo, error)
+		expectedCode string
+	}{
+		{
+			name: "Vector Length Mismatch",
+			call: func() (*v1.AddResponse_ErrorInfo, error) {
+				resp, err := additionService.AddVectors(context.Background(), &v1.AddVectorsRequest{
+					Vectors: []*v1.Vector{{Values: []float64{1, 2}}, {Values: []float64{1, 2, 3}}},
+				})
+				return resp.GetError(), err
+			},
+			expectedCode: "SHAPE_MISMATCH",
+		},
+		{
+			name: "Matrix Shape Mismatch",
+			call: func() (*v1.AddResponse_ErrorInfo, error) {
+				resp, err := additionService.AddMatrices(context.Background(), &v1.AddMatricesRequest{
+					Matrices: []*v1.Matrix{
+						{Rows: 2, Columns: 2, Values: []float64{1, 2, 3, 4}},
+						{Rows: 1, Columns: 4, Values: []float64{1, 2, 3, 4}},
+					},
+				})
+				return resp.GetError(), err
+			},
+			expectedCode: "SHAPE_MISMATCH",
+		},
+		{
+			name: "Matrix Values Do Not Match Shape",
+			call: func() (*v1.AddResponse_ErrorInfo, error) {
+				resp, err := additionService.AddMatrices(context.Background(), &v1.AddMatricesRequest{
+					Matrices: []*v1.Matrix{{Rows: 2, Columns: 2, Values: []float64{1, 2, 3}}},
+				})
+				return resp.GetError(), err
+			},
+			expectedCode: "INVALID_SHAPE",
+		},
+		{
+			name: "No Vectors",
+			call: func() (*v1.AddResponse_ErrorInfo, error) {
+				resp, err := additionService.AddVectors(context.Background(), &v1.AddVectorsRequest{})
+				return resp.GetError(), err
+			},
+			expectedCode: "NO_NUMBERS",
+		},
+		{
+			name: "No Matrices With Negative Max Numbers",
+			call: func() (*v1.AddResponse_ErrorInfo, error) {
+				maxNumbers := int32(-1)
+				resp, err := additionService.AddMatrices(context.Background(), &v1.AddMatricesRequest{
+					Constraints: &v1.AddRequest_Constraints{MaxNumbers: &maxNumbers},
+				})
+				return resp.GetError(), err
+			},
+			expectedCode: "NO_NUMBERS",
+		},
+		{
+			name: "Element Overflow",
+			call: func() (*v1.AddResponse_ErrorInfo, error) {
+				resp, err := additionService.AddVectors(context.Background(), &v1.AddVectorsRequest{
+					Vectors: []*v1.Vector{{Values: []float64{1, math.MaxFloat64}}, {Values: []float64{1, math.MaxFloat64}}},
+				})
+				return resp.GetError(), err
+			},
+			expectedCode: "OVERFLOW",
+		},
+		{
+			name: "Constraints - Min Value",
+			call: func() (*v1.AddResponse_ErrorInfo, error) {
+				resp, err := additionService.AddVectors(context.Background(), &v1.AddVectorsRequest{
+					Vectors:     []*v1.Vector{{Values: []float64{1, -2}}},
+					Constraints: &v1.AddRequest_Constraints{MinValue: floatPtr(0)},
+				})
+				return resp.GetError(), err
+			},
+			expectedCode: "VALUE_TOO_LOW",
+		},
+		{
+			name: "NaN Element",
+			call: func() (*v1.AddResponse_ErrorInfo, error) {
+				resp, err := additionService.AddVectors(context.Background(), &v1.AddVectorsRequest{
+					Vectors: []*v1.Vector{{Values: []float64{1, 2}}, {Values: []float64{math.NaN(), 2}}},
+				})
+				return resp.GetError(), err
+			},
+			expectedCode: "NAN_INPUT",
+		},
+		{
+			name: "Infinite Element",
+			call: func() (*v1.AddResponse_ErrorInfo, error) {
+				resp, err := additionService.AddMatrices(context.Background(), &v1.AddMatricesRequest{
+					Matrices: []*v1.Matrix{{Rows: 1, Columns: 2, Values: []float64{1, math.Inf(1)}}},
+				})
+				return resp.GetError(), err
+			},
+			expectedCode: "INFINITE_INPUT",
+		},
+		{
+			name: "Finite Only",
+			call: func() (*v1.AddResponse_ErrorInfo, error) {
+				resp, err := additionService.AddVectors(context.Background(), &v1.AddVectorsRequest{
+					Vectors: []*v1.Vector{{Values: []float64{1, math.Inf(-1)}}},
+					Constraints: &v1.AddRequest_Constraints{
+						FiniteOnly:     true,
+						InfinityPolicy: v1.NonFinitePolicy_NON_FINITE_POLICY_PROPAGATE,
+					},
+				})
+				return resp.GetError(), err
+			},
+			expectedCode: "NOT_FINITE",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			errInfo, err := tc.call()
+
+			require.Error(t, err)
+			require.NotNil(t, errInfo)
+			assert.Equal(t, tc.expectedCode, errInfo.Code)
+		})
+	}
+}
+
+func TestAdditionService_ElementwiseNonFinitePolicies(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	t.Run("Skip", func(t *testing.T) {
+		resp, err := additionService.AddVectors(context.Background(), &v1.AddVectorsRequest{
+			Vectors: []*v1.Vector{
+				{Values: []float64{1, math.NaN(), 3}},
+				{Values: []float64{2, 4, math.Inf(1)}},
+			},
+			Constraints: &v1.AddRequest_Constraints{
+				NanPolicy:      v1.NonFinitePolicy_NON_FINITE_POLICY_SKIP,
+				InfinityPolicy: v1.NonFinitePolicy_NON_FINITE_POLICY_SKIP,
+			},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []float64{3, 4, 3}, resp.Result.Values)
+	})
+
+	t.Run("Propagate", func(t *testing.T) {
+		resp, err := additionService.AddVectors(context.Background(), &v1.AddVectorsRequest{
+			Vectors: []*v1.Vector{
+				{Values: []float64{1, math.NaN(), math.Inf(1)}},
+				{Values: []float64{2, 4, math.Inf(-1)}},
+			},
+			Constraints: &v1.AddRequest_Constraints{
+				NanPolicy:      v1.NonFinitePolicy_NON_FINITE_POLICY_PROPAGATE,
+				InfinityPolicy: v1.NonFinitePolicy_NON_FINITE_POLICY_PROPAGATE,
+			},
+		})
+
+		require.NoError(t, err)
+		require.Len(t, resp.Result.Values, 3)
+		assert.Equal(t, 3.0, resp.Result.Values[0])
+		assert.True(t, math.IsNaN(resp.Result.Values[1]))
+		assert.True(t, math.IsNaN(resp.Result.Values[2]))
+	})
+}
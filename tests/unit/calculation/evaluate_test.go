@@ -0,0 +1,89 @@
+package calculation
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/service"
+)
+
+func TestAdditionService_Evaluate(t *testing.T) {
+	calculationService := service.NewAdditionService()
+
+	testCases := []struct {
+		name           string
+		expression     string
+		expectedResult float64
+		expectedCount  int32
+	}{
+		{name: "Mixed Precedence", expression: "(1.5 + 2) * 3 - 4 / 2", expectedResult: 8.5, expectedCount: 5},
+		{name: "Unary Minus", expression: "-(2 - 5) * -2", expectedResult: -6, expectedCount: 3},
+		{name: "Left Associativity", expression: "10 - 4 - 3", expectedResult: 3, expectedCount: 3},
+		{name: "Exponent Notation", expression: "1.5e2 / .5", expectedResult: 300, expectedCount: 2},
+		{name: "Maximum Nesting", expression: strings.Repeat("(", 1000) + "1" + strings.Repeat(")", 1000), expectedResult: 1, expectedCount: 1},
+		{name: "Long Sum Chain", expression: "1" + strings.Repeat("+1", 200000), expectedResult: 200001, expectedCount: 200001},
+		{name: "Long Mixed Chain", expression: "2" + strings.Repeat("*1-1", 100000), expectedResult: -99998, expectedCount: 200001},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := calculationService.Evaluate(context.Background(), &v1.EvaluateRequest{
+				Expression: tc.expression,
+			})
+
+			require.NoError(t, err)
+			assert.InDelta(t, tc.expectedResult, resp.Result, 1e-9)
+			assert.NotEmpty(t, resp.RequestId)
+			require.NotNil(t, resp.CalculationMetadata)
+			assert.Equal(t, tc.expectedCount, resp.CalculationMetadata.NumbersProcessed)
+			assert.Equal(t, "expression_evaluation", resp.CalculationMetadata.CalculationMethod)
+		})
+	}
+}
+
+func TestAdditionService_EvaluateErrors(t *testing.T) {
+	calculationService := service.NewAdditionService()
+
+	testCases := []struct {
+		name              string
+		expression        string
+		expectedCode      string
+		expectedPositions []int32
+	}{
+		{name: "Empty Expression", expression: "", expectedCode: "PARSE_ERROR", expectedPositions: []int32{0}},
+		{name: "Trailing Operator", expression: "1 + ", expectedCode: "PARSE_ERROR", expectedPositions: []int32{4}},
+		{name: "Unbalanced Parenthesis", expression: "(1 + 2", expectedCode: "PARSE_ERROR", expectedPositions: []int32{6}},
+		{name: "Unexpected Parenthesis", expression: "1 + 2)", expectedCode: "PARSE_ERROR", expectedPositions: []int32{5}},
+		{name: "Invalid Characters", expression: "1 & 2 # 3", expectedCode: "PARSE_ERROR", expectedPositions: []int32{2, 6}},
+		{name: "Nested Too Deeply", expression: strings.Repeat("(", 100000) + "1", expectedCode: "PARSE_ERROR", expectedPositions: []int32{1000}},
+		{name: "Unary Nested Too Deeply", expression: strings.Repeat("-", 100000) + "1", expectedCode: "PARSE_ERROR", expectedPositions: []int32{1000}},
+		{name: "Division By Zero", expression: "4 / (2 - 2)", expectedCode: "DIVISION_BY_ZERO", expectedPositions: []int32{2}},
+		{name: "Division By Zero After Long Chain", expression: "1" + strings.Repeat("+1", 100000) + "/0", expectedCode: "DIVISION_BY_ZERO", expectedPositions: []int32{200001}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := calculationService.Evaluate(context.Background(), &v1.EvaluateRequest{
+				Expression: tc.expression,
+			})
+
+			require.Error(t, err)
+			require.NotNil(t, resp.Error)
+			assert.Equal(t, tc.expectedCode, resp.Error.Code)
+			require.NotNil(t, resp.Error.Position)
+			assert.Equal(t, tc.expectedPositions[0], *resp.Error.Position)
+
+			if tc.expectedCode == "PARSE_ERROR" {
+				require.Len(t, resp.ParseErrors, len(tc.expectedPositions))
+				for i, parseErr := range resp.ParseErrors {
+					assert.Equal(t, tc.expectedPositions[i], parseErr.GetPosition())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package calculation
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/service"
+)
+
+func TestAdditionService_AddFractions(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	testCases := []struct {
+		name                string
+		fractions           []*v1.Fraction
+		expectedNumerator   string
+		expectedDenominator string
+		expectedResult      float64
+	}{
+		{
+			name: "Reduced Sum",
+			fractions: []*v1.Fraction{
+				{Numerator: "1", Denominator: "3"},
+				{Numerator: "1", Denominator: "6"},
+			},
+			expectedNumerator:   "1",
+			expectedDenominator: "2",
+			expectedResult:      0.5,
+		},
+		{
+			name: "Negative Denominator",
+			fractions: []*v1.Fraction{
+				{Numerator: "1", Denominator: "-4"},
+				{Numerator: "1", Denominator: "8"},
+			},
+			expectedNumerator:   "-1",
+			expectedDenominator: "8",
+			expectedResult:      -0.125,
+		},
+		{
+			name: "Whole Numbers",
+			fractions: []*v1.Fraction{
+				{Numerator: "2"},
+				{Numerator: "3", Denominator: "1"},
+			},
+			expectedNumerator:   "5",
+			expectedDenominator: "1",
+			expectedResult:      5,
+		},
+		{
+			name: "Beyond Int64",
+			fractions: []*v1.Fraction{
+				{Numerator: "1", Denominator: "18446744073709551616"},
+				{Numerator: "1", Denominator: "18446744073709551616"},
+			},
+			expectedNumerator:   "1",
+			expectedDenominator: "9223372036854775808",
+			expectedResult:      1.0 / 9223372036854775808,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := additionService.Add(context.Background(), &v1.AddRequest{
+				FractionNumbers: tc.fractions,
+			})
+
+			require.NoError(t, err)
+			require.NotNil(t, resp.FractionResult)
+			assert.Equal(t, tc.expectedNumerator, resp.FractionResult.Numerator)
+			assert.Equal(t, tc.expectedDenominator, resp.FractionResult.Denominator)
+			assert.Equal(t, tc.expectedResult, resp.Result)
+			require.NotNil(t, resp.CalculationMetadata)
+			assert.Equal(t, "rational", resp.CalculationMetadata.CalculationMethod)
+		})
+	}
+}
+
+func TestAdditionService_AddFractionsErrors(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	testCases := []struct {
+		name         string
+		request      *v1.AddRequest
+		expectedCode string
+	}{
+		{
+			name: "Zero Denominator",
+			request: &v1.AddRequest{
+				FractionNumbers: []*v1.Fraction{{Numerator: "1", Denominator: "0"}},
+			},
+			expectedCode: "INVALID_FRACTION",
+		},
+		{
+			name: "Invalid Numerator",
+			request: &v1.AddRequest{
+				FractionNumbers: []*v1.Fraction{{Numerator: "1.5", Denominator: "2"}},
+			},
+			expectedCode: "INVALID_FRACTION",
+		},
+		{
+			name: "Above Maximum",
+			request: &v1.AddRequest{
+				FractionNumbers: []*v1.Fraction{{Numerator: "7", Denominator: "2"}},
+				Constraints:     &v1.AddRequest_Constraints{MaxValue: floatPtr(3)},
+			},
+			expectedCode: "VALUE_TOO_HIGH",
+		},
+		{
+			name: "Mixed With Decimals",
+			request: &v1.AddRequest{
+				DecimalNumbers:  []string{"0.5"},
+				FractionNumbers: []*v1.Fraction{{Numerator: "1", Denominator: "2"}},
+			},
+			expectedCode: "MIXED_OPERANDS",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := additionService.Add(context.Background(), tc.request)
+
+			require.Error(t, err)
+			require.NotNil(t, resp.Error)
+			assert.Equal(t, tc.expectedCode, resp.Error.Code)
+		})
+	}
+}
+
+func TestAdditionService_AddFractionsNonFiniteBounds(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	resp, err := additionService.Add(context.Background(), &v1.AddRequest{
+		FractionNumbers: []*v1.Fraction{{Numerator: "1", Denominator: "3"}, {Numerator: "1", Denominator: "6"}},
+		Constraints:     &v1.AddRequest_Constraints{MinValue: floatPtr(math.Inf(-1)), MaxValue: floatPtr(math.Inf(1))},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "1", resp.FractionResult.Numerator)
+	assert.Equal(t, "2", resp.FractionResult.Denominator)
+
+	resp, err = additionService.Add(context.Background(), &v1.AddRequest{
+		FractionNumbers: []*v1.Fraction{{Numerator: "1", Denominator: "3"}},
+		Constraints:     &v1.AddRequest_Constraints{MaxValue: floatPtr(math.Inf(-1))},
+	})
+	require.Error(t, err)
+	assert.Equal(t, "VALUE_TOO_HIGH", resp.Error.Code)
+
+	resp, err = additionService.Add(context.Background(), &v1.AddRequest{
+		FractionNumbers: []*v1.Fraction{{Numerator: "1", Denominator: "3"}},
+		Constraints:     &v1.AddRequest_Constraints{MinValue: floatPtr(math.NaN())},
+	})
+	require.Error(t, err)
+	assert.Equal(t, "INVALID_CONSTRAINT", resp.Error.Code)
+}
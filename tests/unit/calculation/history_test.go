@@ -0,0 +1,444 @@
+package calculation
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1 "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/service"
+)
+
+// historyRecord is the on-disk form of a recorded calculation
+type historyRecord struct {
+	RequestID         string          `json:"request_id"`
+	Operation         string          `json:"operation"`
+	Request           json.RawMessage `json:"request"`
+	Response          json.RawMessage `json:"response"`
+	ErrorCode         string          `json:"error_code"`
+	CalculationMethod string          `json:"calculation_method"`
+	Duration          int64           `json:"duration"`
+}
+
+// readHistory decodes every record of a history file
+func readHistory(t *testing.T, path string) []historyRecord {
+	t.Helper()
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var records []historyRecord
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record historyRecord
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	require.NoError(t, scanner.Err())
+	return records
+}
+
+func TestAdditionService_HistoryFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+
+	additionService := service.NewAdditionService()
+	require.NoError(t, additionService.OpenHistoryFile(path))
+
+	_, err := additionService.Add(context.Background(), &v1.AddRequest{
+		RequestId: "history-add",
+		Numbers:   []float64{1, 2},
+	})
+	require.NoError(t, err)
+
+	_, err = additionService.Divide(context.Background(), &v1.DivideRequest{
+		RequestId: "history-divide",
+		Numbers:   []float64{1, 0},
+	})
+	require.Error(t, err)
+	require.NoError(t, additionService.Close())
+
+	records := readHistory(t, path)
+	require.Len(t, records, 2)
+
+	assert.Equal(t, "history-add", records[0].RequestID)
+	assert.Equal(t, "add", records[0].Operation)
+	assert.Equal(t, "simple_addition", records[0].CalculationMethod)
+	assert.Empty(t, records[0].ErrorCode)
+	assert.JSONEq(t, `{"requestId": "history-add", "numbers": [1, 2]}`, string(records[0].Request))
+
+	var response map[string]any
+	require.NoError(t, json.Unmarshal(records[0].Response, &response))
+	assert.Equal(t, 3.0, response["result"])
+	assert.GreaterOrEqual(t, records[0].Duration, int64(0))
+
+	assert.Equal(t, "history-divide", records[1].RequestID)
+	assert.Equal(t, "divide", records[1].Operation)
+	assert.Equal(t, "DIVISION_BY_ZERO", records[1].ErrorCode)
+}
+
+func TestAdditionService_HistoryFileBurst(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+
+	additionService := service.NewAdditionService()
+	require.NoError(t, additionService.OpenHistoryFile(path))
+
+	// Calculations arriving at once are saved in batches, each written and
+	// synced together
+	const calculations = 3000
+	var wg sync.WaitGroup
+	for i := range calculations {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := additionService.Add(context.Background(), &v1.AddRequest{
+				RequestId: "history-burst-" + strconv.Itoa(i),
+				Numbers:   []float64{1, 2},
+			})
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	require.NoError(t, additionService.Close())
+
+	records := readHistory(t, path)
+	require.Equal(t, calculations, len(records))
+	seen := make(map[string]bool)
+	for _, record := range records {
+		seen[record.RequestID] = true
+	}
+	assert.Len(t, seen, calculations)
+}
+
+func TestAdditionService_HistoryFileReopened(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+
+	for _, requestID := range []string{"first-run", "second-run"} {
+		additionService := service.NewAdditionService()
+		require.NoError(t, additionService.OpenHistoryFile(path))
+
+		_, err := additionService.Subtract(context.Background(), &v1.SubtractRequest{
+			RequestId: requestID,
+			Numbers:   []float64{5, 3},
+		})
+		require.NoError(t, err)
+		require.NoError(t, additionService.Close())
+	}
+
+	records := readHistory(t, path)
+	require.Len(t, records, 2)
+	assert.Equal(t, "first-run", records[0].RequestID)
+	assert.Equal(t, "second-run", records[1].RequestID)
+}
+
+// oldRecord is a history line written by an earlier run
+const oldRecord = `{"request_id":"old-run","operation":"add","request":{"numbers":[1]},"response":{"result":1},"time":"2026-01-01T00:00:00Z","duration":0}`
+
+func TestAdditionService_HistoryFileCorrupt(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte("not json\n"+oldRecord+"\n"), 0o644))
+
+	additionService := service.NewAdditionService()
+	assert.Error(t, additionService.OpenHistoryFile(path))
+}
+
+func TestAdditionService_HistoryFileRepaired(t *testing.T) {
+	testCases := []struct {
+		name     string
+		contents string
+	}{
+		{name: "Truncated Last Line", contents: oldRecord + "\n" + `{"request_id":"interr`},
+		{name: "Corrupt Last Line", contents: oldRecord + "\nnot json\n"},
+		{name: "Missing Newline", contents: oldRecord},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "history.jsonl")
+			require.NoError(t, os.WriteFile(path, []byte(tc.contents), 0o644))
+
+			additionService := service.NewAdditionService()
+			require.NoError(t, additionService.OpenHistoryFile(path))
+			_, err := additionService.Add(context.Background(), &v1.AddRequest{
+				RequestId: "new-run",
+				Numbers:   []float64{1, 2},
+			})
+			require.NoError(t, err)
+
+			for _, requestID := range []string{"old-run", "new-run"} {
+				resp, err := additionService.GetCalculation(context.Background(), &v1.GetCalculationRequest{RequestId: requestID})
+				require.NoError(t, err, requestID)
+				assert.Equal(t, "add", resp.Calculation.Operation)
+			}
+			require.NoError(t, additionService.Close())
+
+			records := readHistory(t, path)
+			require.Len(t, records, 2)
+			assert.Equal(t, "old-run", records[0].RequestID)
+			assert.Equal(t, "new-run", records[1].RequestID)
+		})
+	}
+}
+
+func TestAdditionService_GetCalculation(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	_, err := additionService.Add(context.Background(), &v1.AddRequest{
+		RequestId: "lookup-request-id",
+		Numbers:   []float64{1, 2},
+	})
+	require.NoError(t, err)
+
+	resp, err := additionService.GetCalculation(context.Background(), &v1.GetCalculationRequest{
+		RequestId: "lookup-request-id",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, resp.Calculation)
+	assert.Equal(t, "add", resp.Calculation.Operation)
+	assert.Equal(t, "simple_addition", resp.Calculation.CalculationMethod)
+	assert.JSONEq(t, `{"requestId": "lookup-request-id", "numbers": [1, 2]}`, resp.Calculation.Request)
+	assert.NotNil(t, resp.Calculation.CalculationTime)
+	assert.NotNil(t, resp.Calculation.Duration)
+
+	resp, err = additionService.GetCalculation(context.Background(), &v1.GetCalculationRequest{
+		RequestId: "unknown-request-id",
+	})
+	require.Error(t, err)
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, "CALCULATION_NOT_FOUND", resp.Error.Code)
+}
+
+func TestAdditionService_GetCalculationTruncated(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	numbers := make([]float64, 1000)
+	for i := range numbers {
+		numbers[i] = 1
+	}
+	_, err := additionService.Add(context.Background(), &v1.AddRequest{
+		RequestId: "large-request-id",
+		Numbers:   numbers,
+	})
+	require.NoError(t, err)
+
+	resp, err := additionService.GetCalculation(context.Background(), &v1.GetCalculationRequest{
+		RequestId: "large-request-id",
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.Calculation.Truncated)
+	assert.Equal(t, int32(1000), resp.Calculation.NumbersProcessed)
+
+	var request struct {
+		Numbers []float64 `json:"numbers"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(resp.Calculation.Request), &request))
+	assert.Len(t, request.Numbers, 16)
+}
+
+func TestAdditionService_GetCalculationOperations(t *testing.T) {
+	additionService := service.NewAdditionService()
+	ctx := context.Background()
+
+	_, err := additionService.Evaluate(ctx, &v1.EvaluateRequest{RequestId: "history-evaluate", Expression: "1 + 2"})
+	require.NoError(t, err)
+	_, err = additionService.Describe(ctx, &v1.DescribeRequest{RequestId: "history-describe", Numbers: []float64{1, 2}})
+	require.NoError(t, err)
+	_, err = additionService.AddVectors(ctx, &v1.AddVectorsRequest{
+		RequestId: "history-vectors",
+		Vectors:   []*v1.Vector{{Values: []float64{1}}, {Values: []float64{2}}},
+	})
+	require.NoError(t, err)
+	_, err = additionService.AddMatrices(ctx, &v1.AddMatricesRequest{
+		RequestId: "history-matrices",
+		Matrices:  []*v1.Matrix{{Rows: 1, Columns: 1, Values: []float64{1}}},
+	})
+	require.NoError(t, err)
+
+	for requestID, operation := range map[string]string{
+		"history-evaluate": "evaluate",
+		"history-describe": "describe",
+		"history-vectors":  "add_vectors",
+		"history-matrices": "add_matrices",
+	} {
+		resp, err := additionService.GetCalculation(ctx, &v1.GetCalculationRequest{RequestId: requestID})
+		require.NoError(t, err, requestID)
+		assert.Equal(t, operation, resp.Calculation.Operation)
+	}
+}
+
+func TestAdditionService_GetCalculationReplayed(t *testing.T) {
+	additionService := service.NewAdditionService()
+	ctx := context.Background()
+
+	req := &v1.AddRequest{RequestId: "replayed-request-id", Numbers: []float64{1, 2}}
+	_, err := additionService.Add(ctx, req)
+	require.NoError(t, err)
+	_, err = additionService.Add(ctx, req)
+	require.NoError(t, err)
+
+	_, err = additionService.Add(ctx, &v1.AddRequest{RequestId: "replayed-request-id", Numbers: []float64{3, 4}})
+	require.Error(t, err)
+
+	resp, err := additionService.GetCalculation(ctx, &v1.GetCalculationRequest{RequestId: "replayed-request-id"})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Calculation.ErrorCode)
+	assert.JSONEq(t, `{"requestId": "replayed-request-id", "numbers": [1, 2]}`, resp.Calculation.Request)
+
+	list, err := additionService.ListCalculations(ctx, &v1.ListCalculationsRequest{RequestIdPrefix: "replayed-"})
+	require.NoError(t, err)
+	assert.Len(t, list.Calculations, 1)
+}
+
+func TestAdditionService_ListCalculations(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	start := time.Now()
+	for _, req := range []*v1.AddRequest{
+		{RequestId: "customer-a-1", Numbers: []float64{1, 2}},
+		{RequestId: "customer-b-1", Numbers: []float64{1, 2}, Algorithm: v1.SummationAlgorithm_SUMMATION_ALGORITHM_KAHAN},
+		{RequestId: "customer-a-2", Numbers: []float64{150}, Constraints: &v1.AddRequest_Constraints{MaxValue: floatPtr(100)}},
+		{RequestId: "customer-a-3", Numbers: []float64{3, 4}},
+	} {
+		additionService.Add(context.Background(), req)
+	}
+
+	requestIDs := func(calculations []*v1.Calculation) []string {
+		ids := make([]string, len(calculations))
+		for i, calculation := range calculations {
+			ids[i] = calculation.RequestId
+		}
+		return ids
+	}
+
+	testCases := []struct {
+		name     string
+		request  *v1.ListCalculationsRequest
+		expected []string
+	}{
+		{
+			name:     "Newest First",
+			request:  &v1.ListCalculationsRequest{},
+			expected: []string{"customer-a-3", "customer-a-2", "customer-b-1", "customer-a-1"},
+		},
+		{
+			name:     "Request ID Prefix",
+			request:  &v1.ListCalculationsRequest{RequestIdPrefix: "customer-a-"},
+			expected: []string{"customer-a-3", "customer-a-2", "customer-a-1"},
+		},
+		{
+			name:     "Error Code",
+			request:  &v1.ListCalculationsRequest{ErrorCode: "VALUE_TOO_HIGH"},
+			expected: []string{"customer-a-2"},
+		},
+		{
+			name:     "Calculation Method",
+			request:  &v1.ListCalculationsRequest{CalculationMethod: "kahan_summation"},
+			expected: []string{"customer-b-1"},
+		},
+		{
+			name:     "Time Range",
+			request:  &v1.ListCalculationsRequest{StartTime: timestamppb.New(start), EndTime: timestamppb.New(start)},
+			expected: []string{},
+		},
+		{
+			name:     "Open Time Range",
+			request:  &v1.ListCalculationsRequest{StartTime: timestamppb.New(start), RequestIdPrefix: "customer-b-"},
+			expected: []string{"customer-b-1"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := additionService.ListCalculations(context.Background(), tc.request)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, requestIDs(resp.Calculations))
+			assert.Empty(t, resp.NextPageToken)
+		})
+	}
+
+	t.Run("Pages", func(t *testing.T) {
+		var pages [][]string
+		token := ""
+		for {
+			resp, err := additionService.ListCalculations(context.Background(), &v1.ListCalculationsRequest{
+				RequestIdPrefix: "customer-a-",
+				PageSize:        2,
+				PageToken:       token,
+			})
+			require.NoError(t, err)
+			pages = append(pages, requestIDs(resp.Calculations))
+			if resp.NextPageToken == "" {
+				break
+			}
+			token = resp.NextPageToken
+		}
+		assert.Equal(t, [][]string{{"customer-a-3", "customer-a-2"}, {"customer-a-1"}}, pages)
+	})
+
+	t.Run("Invalid Page Token", func(t *testing.T) {
+		resp, err := additionService.ListCalculations(context.Background(), &v1.ListCalculationsRequest{
+			PageToken: "not-a-token",
+		})
+		require.Error(t, err)
+		require.NotNil(t, resp.Error)
+		assert.Equal(t, "INVALID_PAGE_TOKEN", resp.Error.Code)
+	})
+
+	t.Run("Negative Page Size", func(t *testing.T) {
+		resp, err := additionService.ListCalculations(context.Background(), &v1.ListCalculationsRequest{
+			PageSize: -1,
+		})
+		require.Error(t, err)
+		require.NotNil(t, resp.Error)
+		assert.Equal(t, "INVALID_PAGE_SIZE", resp.Error.Code)
+	})
+}
+
+func TestAdditionService_ListCalculationsFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history.jsonl")
+
+	for _, requestID := range []string{"file-1", "file-2", "file-3"} {
+		additionService := service.NewAdditionService()
+		require.NoError(t, additionService.OpenHistoryFile(path))
+		_, err := additionService.Add(context.Background(), &v1.AddRequest{
+			RequestId: requestID,
+			Numbers:   []float64{1, 2},
+		})
+		require.NoError(t, err)
+		require.NoError(t, additionService.Close())
+	}
+
+	additionService := service.NewAdditionService()
+	require.NoError(t, additionService.OpenHistoryFile(path))
+	defer additionService.Close()
+
+	resp, err := additionService.ListCalculations(context.Background(), &v1.ListCalculationsRequest{PageSize: 2})
+	require.NoError(t, err)
+	require.Len(t, resp.Calculations, 2)
+	assert.Equal(t, "file-3", resp.Calculations[0].RequestId)
+	assert.Equal(t, "file-2", resp.Calculations[1].RequestId)
+	assert.JSONEq(t, `{"requestId": "file-2", "numbers": [1, 2]}`, resp.Calculations[1].Request)
+
+	resp, err = additionService.ListCalculations(context.Background(), &v1.ListCalculationsRequest{
+		PageSize:  2,
+		PageToken: resp.NextPageToken,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Calculations, 1)
+	assert.Equal(t, "file-1", resp.Calculations[0].RequestId)
+	assert.Empty(t, resp.NextPageToken)
+
+	get, err := additionService.GetCalculation(context.Background(), &v1.GetCalculationRequest{RequestId: "file-1"})
+	require.NoError(t, err)
+	assert.Equal(t, "add", get.Calculation.Operation)
+}
@@ -0,0 +1,170 @@
+package calculation
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1 "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/service"
+)
+
+func TestAdditionService_IdempotentRetry(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	first, err := additionService.Add(context.Background(), &v1.AddRequest{
+		RequestId:   "retry-request-id",
+		Numbers:     []float64{1.5, 2.5},
+		RequestTime: timestamppb.Now(),
+	})
+	require.NoError(t, err)
+
+	// A retry may carry a new request time
+	retry, err := additionService.Add(context.Background(), &v1.AddRequest{
+		RequestId:   "retry-request-id",
+		Numbers:     []float64{1.5, 2.5},
+		RequestTime: timestamppb.Now(),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 4.0, retry.Result)
+	assert.Equal(t, "retry-request-id", retry.RequestId)
+	assert.True(t, first.CalculationMetadata.CalculationTime.AsTime().Equal(retry.CalculationMetadata.CalculationTime.AsTime()),
+		"a retry returns the stored response")
+}
+
+func TestAdditionService_IdempotencyConflict(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	_, err := additionService.Add(context.Background(), &v1.AddRequest{
+		RequestId: "conflict-request-id",
+		Numbers:   []float64{1, 2},
+	})
+	require.NoError(t, err)
+
+	resp, err := additionService.Add(context.Background(), &v1.AddRequest{
+		RequestId: "conflict-request-id",
+		Numbers:   []float64{1, 3},
+	})
+	require.Error(t, err)
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, "IDEMPOTENCY_CONFLICT", resp.Error.Code)
+	assert.Equal(t, "conflict-request-id", resp.RequestId)
+
+	// The conflict does not replace the stored response
+	resp, err = additionService.Add(context.Background(), &v1.AddRequest{
+		RequestId: "conflict-request-id",
+		Numbers:   []float64{1, 2},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3.0, resp.Result)
+}
+
+func TestAdditionService_IdempotentErrorReplay(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	for i := 0; i < 2; i++ {
+		resp, err := additionService.Add(context.Background(), &v1.AddRequest{
+			RequestId:   "failed-request-id",
+			Numbers:     []float64{150},
+			Constraints: &v1.AddRequest_Constraints{MaxValue: floatPtr(100)},
+		})
+		require.Error(t, err)
+		require.NotNil(t, resp.Error)
+		assert.Equal(t, "VALUE_TOO_HIGH", resp.Error.Code)
+	}
+}
+
+func TestAdditionService_RequestsWithoutIDAreRecalculated(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	first, err := additionService.Add(context.Background(), &v1.AddRequest{Numbers: []float64{1, 2}})
+	require.NoError(t, err)
+	second, err := additionService.Add(context.Background(), &v1.AddRequest{Numbers: []float64{1, 2}})
+	require.NoError(t, err)
+
+	assert.NotEqual(t, first.RequestId, second.RequestId)
+}
+
+func TestAdditionService_IdempotentArithmetic(t *testing.T) {
+	additionService := service.NewAdditionService()
+	ctx := context.Background()
+
+	first, err := additionService.Subtract(ctx, &v1.SubtractRequest{RequestId: "subtract-request-id", Numbers: []float64{5, 3}})
+	require.NoError(t, err)
+	retry, err := additionService.Subtract(ctx, &v1.SubtractRequest{RequestId: "subtract-request-id", Numbers: []float64{5, 3}})
+	require.NoError(t, err)
+	assert.Equal(t, 2.0, retry.Result)
+	assert.True(t, first.CalculationMetadata.CalculationTime.AsTime().Equal(retry.CalculationMetadata.CalculationTime.AsTime()),
+		"a retry returns the stored response")
+
+	multiply, err := additionService.Multiply(ctx, &v1.MultiplyRequest{RequestId: "multiply-request-id", Numbers: []float64{2, 3}})
+	require.NoError(t, err)
+	assert.Equal(t, 6.0, multiply.Result)
+	multiply, err = additionService.Multiply(ctx, &v1.MultiplyRequest{RequestId: "multiply-request-id", Numbers: []float64{2, 4}})
+	require.Error(t, err)
+	require.NotNil(t, multiply.Error)
+	assert.Equal(t, "IDEMPOTENCY_CONFLICT", multiply.Error.Code)
+	assert.Equal(t, "multiply-request-id", multiply.RequestId)
+
+	for i := 0; i < 2; i++ {
+		divide, err := additionService.Divide(ctx, &v1.DivideRequest{RequestId: "divide-request-id", Numbers: []float64{1, 0}})
+		require.Error(t, err)
+		require.NotNil(t, divide.Error)
+		assert.Equal(t, "DIVISION_BY_ZERO", divide.Error.Code)
+	}
+
+	describe, err := additionService.Describe(ctx, &v1.DescribeRequest{RequestId: "describe-request-id", Numbers: []float64{1, 2}})
+	require.NoError(t, err)
+	retried, err := additionService.Describe(ctx, &v1.DescribeRequest{RequestId: "describe-request-id", Numbers: []float64{1, 2}})
+	require.NoError(t, err)
+	assert.Equal(t, 3.0, retried.Sum)
+	assert.True(t, describe.CalculationMetadata.CalculationTime.AsTime().Equal(retried.CalculationMetadata.CalculationTime.AsTime()),
+		"a retry returns the stored response")
+
+	// The same payload for another operation is a different request
+	add, err := additionService.Add(ctx, &v1.AddRequest{RequestId: "subtract-request-id", Numbers: []float64{5, 3}})
+	require.Error(t, err)
+	require.NotNil(t, add.Error)
+	assert.Equal(t, "IDEMPOTENCY_CONFLICT", add.Error.Code)
+}
+
+func TestAdditionService_IdempotentConcurrentRetries(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	const retries = 50
+	results := make([]*v1.AddResponse, retries)
+	var wg sync.WaitGroup
+	for i := 0; i < retries; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			resp, err := additionService.Add(context.Background(), &v1.AddRequest{
+				RequestId: "concurrent-request-id",
+				Numbers:   []float64{1, 2},
+			})
+			assert.NoError(t, err)
+			results[i] = resp
+		}(i)
+	}
+	wg.Wait()
+
+	for i, resp := range results {
+		require.NotNil(t, resp, fmt.Sprintf("retry %d", i))
+		assert.Equal(t, 3.0, resp.Result)
+		assert.True(t, results[0].CalculationMetadata.CalculationTime.AsTime().Equal(resp.CalculationMetadata.CalculationTime.AsTime()),
+			"every retry gets the response of a single calculation")
+	}
+
+	// Only the single calculation is recorded
+	list, err := additionService.ListCalculations(context.Background(), &v1.ListCalculationsRequest{
+		RequestIdPrefix: "concurrent-request-id",
+	})
+	require.NoError(t, err)
+	assert.Len(t, list.Calculations, 1)
+}
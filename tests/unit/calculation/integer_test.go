@@ -0,0 +1,159 @@
+package calculation
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/service"
+)
+
+func TestAdditionService_AddInteger(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	testCases := []struct {
+		name              string
+		request           *v1.AddRequest
+		expectedInteger   int64
+		expectedBigResult string
+		expectedMethod    string
+	}{
+		{
+			name: "Exact Above 2^53",
+			request: &v1.AddRequest{
+				IntegerNumbers: []int64{9007199254740993, 1, 1},
+			},
+			expectedInteger:   9007199254740995,
+			expectedBigResult: "9007199254740995",
+			expectedMethod:    "integer",
+		},
+		{
+			name: "Big Integer Strings",
+			request: &v1.AddRequest{
+				IntegerNumbers:    []int64{-5},
+				BigIntegerNumbers: []string{"100", "-95"},
+			},
+			expectedInteger:   0,
+			expectedBigResult: "0",
+			expectedMethod:    "integer",
+		},
+		{
+			name: "Promotion Beyond Int64",
+			request: &v1.AddRequest{
+				IntegerNumbers:      []int64{math.MaxInt64, math.MaxInt64},
+				PromoteToBigInteger: true,
+			},
+			expectedBigResult: "18446744073709551614",
+			expectedMethod:    "big_integer",
+		},
+		{
+			name: "Promotion Back Into Int64 Range",
+			request: &v1.AddRequest{
+				BigIntegerNumbers:   []string{"123456789012345678901234567890", "-123456789012345678901234567880"},
+				PromoteToBigInteger: true,
+			},
+			expectedInteger:   10,
+			expectedBigResult: "10",
+			expectedMethod:    "big_integer",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := additionService.Add(context.Background(), tc.request)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedInteger, resp.IntegerResult)
+			assert.Equal(t, tc.expectedBigResult, resp.BigIntegerResult)
+			require.NotNil(t, resp.CalculationMetadata)
+			assert.Equal(t, tc.expectedMethod, resp.CalculationMetadata.CalculationMethod)
+		})
+	}
+}
+
+func TestAdditionService_AddIntegerErrors(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	testCases := []struct {
+		name         string
+		request      *v1.AddRequest
+		expectedCode string
+	}{
+		{
+			name: "Intermediate Overflow",
+			request: &v1.AddRequest{
+				IntegerNumbers: []int64{math.MaxInt64, 1, -1},
+			},
+			expectedCode: "INTEGER_OVERFLOW",
+		},
+		{
+			name: "Big Operand Without Promotion",
+			request: &v1.AddRequest{
+				BigIntegerNumbers: []string{"99999999999999999999"},
+			},
+			expectedCode: "INTEGER_OVERFLOW",
+		},
+		{
+			name: "Invalid Big Integer",
+			request: &v1.AddRequest{
+				BigIntegerNumbers: []string{"12.5"},
+			},
+			expectedCode: "INVALID_INTEGER",
+		},
+		{
+			name: "Mixed With Doubles",
+			request: &v1.AddRequest{
+				Numbers:        []float64{1.0},
+				IntegerNumbers: []int64{1},
+			},
+			expectedCode: "MIXED_OPERANDS",
+		},
+		{
+			name: "Constraints - Min Value",
+			request: &v1.AddRequest{
+				IntegerNumbers: []int64{5, -3},
+				Constraints:    &v1.AddRequest_Constraints{MinValue: floatPtr(0)},
+			},
+			expectedCode: "VALUE_TOO_LOW",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := additionService.Add(context.Background(), tc.request)
+
+			require.Error(t, err)
+			require.NotNil(t, resp.Error)
+			assert.Equal(t, tc.expectedCode, resp.Error.Code)
+		})
+	}
+}
+
+func TestAdditionService_AddIntegerNonFiniteBounds(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	resp, err := additionService.Add(context.Background(), &v1.AddRequest{
+		IntegerNumbers: []int64{math.MaxInt64, math.MinInt64},
+		Constraints:    &v1.AddRequest_Constraints{MaxValue: floatPtr(math.Inf(1)), MinValue: floatPtr(math.Inf(-1))},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(-1), resp.IntegerResult)
+
+	resp, err = additionService.Add(context.Background(), &v1.AddRequest{
+		IntegerNumbers: []int64{1},
+		Constraints:    &v1.AddRequest_Constraints{MaxValue: floatPtr(math.Inf(-1))},
+	})
+	require.Error(t, err)
+	assert.Equal(t, "VALUE_TOO_HIGH", resp.Error.Code)
+
+	resp, err = additionService.Add(context.Background(), &v1.AddRequest{
+		IntegerNumbers: []int64{1},
+		Constraints:    &v1.AddRequest_Constraints{MinValue: floatPtr(math.NaN())},
+	})
+	require.Error(t, err)
+	assert.Equal(t, "INVALID_CONSTRAINT", resp.Error.Code)
+}
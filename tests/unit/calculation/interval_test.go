@@ -0,0 +1,141 @@
+package calculation
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/service"
+)
+
+func TestAdditionService_AddIntervals(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	testCases := []struct {
+		name      string
+		intervals []*v1.Interval
+		// exact sums of the lower and upper input bounds
+		exactLo, exactHi string
+	}{
+		{
+			name: "Exact Bounds",
+			intervals: []*v1.Interval{
+				{Lo: 1, Hi: 2},
+				{Lo: -0.5, Hi: 0.5},
+			},
+			exactLo: "0.5",
+			exactHi: "2.5",
+		},
+		{
+			name: "Inexact Decimal Bounds",
+			intervals: []*v1.Interval{
+				{Lo: 0.1, Hi: 0.1},
+				{Lo: 0.2, Hi: 0.2},
+			},
+			exactLo: "0.3000000000000000166533453693773481063544750213623046875",
+			exactHi: "0.3000000000000000166533453693773481063544750213623046875",
+		},
+		{
+			name: "Large And Small Bounds",
+			intervals: []*v1.Interval{
+				{Lo: 1e16, Hi: 1e16},
+				{Lo: 1, Hi: 1},
+				{Lo: 1, Hi: 1},
+			},
+			exactLo: "10000000000000002",
+			exactHi: "10000000000000002",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := additionService.Add(context.Background(), &v1.AddRequest{
+				IntervalNumbers: tc.intervals,
+			})
+
+			require.NoError(t, err)
+			require.NotNil(t, resp.IntervalResult)
+			exactLo, _ := new(big.Rat).SetString(tc.exactLo)
+			exactHi, _ := new(big.Rat).SetString(tc.exactHi)
+			lo := new(big.Rat).SetFloat64(resp.IntervalResult.Lo)
+			hi := new(big.Rat).SetFloat64(resp.IntervalResult.Hi)
+			assert.LessOrEqual(t, lo.Cmp(exactLo), 0, "lower bound %v above exact sum", resp.IntervalResult.Lo)
+			assert.GreaterOrEqual(t, hi.Cmp(exactHi), 0, "upper bound %v below exact sum", resp.IntervalResult.Hi)
+			assert.LessOrEqual(t, resp.IntervalResult.Lo, resp.Result)
+			assert.GreaterOrEqual(t, resp.IntervalResult.Hi, resp.Result)
+			require.NotNil(t, resp.CalculationMetadata)
+			assert.Equal(t, "interval_arithmetic", resp.CalculationMetadata.CalculationMethod)
+		})
+	}
+}
+
+func TestAdditionService_AddIntervalsUncertainty(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	value := 10.0
+	resp, err := additionService.Add(context.Background(), &v1.AddRequest{
+		IntervalNumbers: []*v1.Interval{
+			{Value: &value, Uncertainty: 0.1},
+			{Lo: 1, Hi: 1},
+		},
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, resp.IntervalResult)
+	assert.InDelta(t, 10.9, resp.IntervalResult.Lo, 1e-12)
+	assert.InDelta(t, 11.1, resp.IntervalResult.Hi, 1e-12)
+	assert.Less(t, resp.IntervalResult.Lo, 10.9)
+	assert.Greater(t, resp.IntervalResult.Hi, 11.1)
+}
+
+func TestAdditionService_AddIntervalsErrors(t *testing.T) {
+	additionService := service.NewAdditionService()
+	value := 1.0
+
+	testCases := []struct {
+		name         string
+		intervals    []*v1.Interval
+		expectedCode string
+	}{
+		{
+			name:         "Reversed Bounds",
+			intervals:    []*v1.Interval{{Lo: 2, Hi: 1}},
+			expectedCode: "INVALID_INTERVAL",
+		},
+		{
+			name:         "Negative Uncertainty",
+			intervals:    []*v1.Interval{{Value: &value, Uncertainty: -1}},
+			expectedCode: "INVALID_INTERVAL",
+		},
+		{
+			name:         "NaN Bound",
+			intervals:    []*v1.Interval{{Lo: math.NaN(), Hi: 1}},
+			expectedCode: "INVALID_INTERVAL",
+		},
+		{
+			name: "Overflow",
+			intervals: []*v1.Interval{
+				{Lo: 1, Hi: math.MaxFloat64},
+				{Lo: 1, Hi: math.MaxFloat64},
+			},
+			expectedCode: "OVERFLOW",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := additionService.Add(context.Background(), &v1.AddRequest{
+				IntervalNumbers: tc.intervals,
+			})
+
+			require.Error(t, err)
+			require.NotNil(t, resp.Error)
+			assert.Equal(t, tc.expectedCode, resp.Error.Code)
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package calculation
+
+import (
+	"context"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/service"
+)
+
+// largeBatch builds a batch that takes long enough to be cancelled while it
+// is running
+func largeBatch() *v1.BatchAddRequest {
+	requests := make([]*v1.AddRequest, 200000)
+	for i := range requests {
+		requests[i] = &v1.AddRequest{Numbers: []float64{1, 2}}
+	}
+	return &v1.BatchAddRequest{Requests: requests}
+}
+
+func TestAdditionService_SubmitAndWaitJob(t *testing.T) {
+	additionService := service.NewAdditionService()
+	defer additionService.Close()
+
+	t.Run("Addition", func(t *testing.T) {
+		submit, err := additionService.SubmitCalculation(context.Background(), &v1.SubmitCalculationRequest{
+			Add: &v1.AddRequest{Numbers: []float64{1, 2, 3}},
+		})
+		require.NoError(t, err)
+		require.NotEmpty(t, submit.Job.JobId)
+		assert.NotNil(t, submit.Job.SubmitTime)
+
+		wait, err := additionService.WaitJob(context.Background(), &v1.WaitJobRequest{JobId: submit.Job.JobId})
+		require.NoError(t, err)
+		assert.Equal(t, v1.JobStatus_JOB_STATUS_DONE, wait.Job.Status)
+		assert.Equal(t, 6.0, wait.Job.AddResponse.Result)
+		assert.Nil(t, wait.Job.BatchAddResponse)
+		assert.NotNil(t, wait.Job.StartTime)
+		assert.NotNil(t, wait.Job.EndTime)
+
+		get, err := additionService.GetJob(context.Background(), &v1.GetJobRequest{JobId: submit.Job.JobId})
+		require.NoError(t, err)
+		assert.Equal(t, v1.JobStatus_JOB_STATUS_DONE, get.Job.Status)
+	})
+
+	t.Run("BatchAddition", func(t *testing.T) {
+		submit, err := additionService.SubmitCalculation(context.Background(), &v1.SubmitCalculationRequest{
+			BatchAdd: &v1.BatchAddRequest{Requests: []*v1.AddRequest{
+				{Numbers: []float64{1, 2}},
+				{Numbers: []float64{}},
+			}},
+		})
+		require.NoError(t, err)
+
+		wait, err := additionService.WaitJob(context.Background(), &v1.WaitJobRequest{JobId: submit.Job.JobId})
+		require.NoError(t, err)
+		assert.Equal(t, v1.JobStatus_JOB_STATUS_DONE, wait.Job.Status)
+		require.Len(t, wait.Job.BatchAddResponse.Responses, 2)
+		assert.Equal(t, int32(1), wait.Job.BatchAddResponse.FailedCount)
+	})
+
+	t.Run("FailedAddition", func(t *testing.T) {
+		submit, err := additionService.SubmitCalculation(context.Background(), &v1.SubmitCalculationRequest{
+			Add: &v1.AddRequest{Numbers: []float64{}},
+		})
+		require.NoError(t, err)
+
+		wait, err := additionService.WaitJob(context.Background(), &v1.WaitJobRequest{JobId: submit.Job.JobId})
+		require.NoError(t, err)
+		assert.Equal(t, v1.JobStatus_JOB_STATUS_FAILED, wait.Job.Status)
+		assert.Equal(t, "NO_NUMBERS", wait.Job.AddResponse.Error.Code)
+	})
+}
+
+func TestAdditionService_CancelJob(t *testing.T) {
+	// A single worker keeps the second job queued behind the first
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(1))
+	additionService := service.NewAdditionService()
+	defer additionService.Close()
+
+	running, err := additionService.SubmitCalculation(context.Background(), &v1.SubmitCalculationRequest{
+		BatchAdd: largeBatch(),
+	})
+	require.NoError(t, err)
+	queued, err := additionService.SubmitCalculation(context.Background(), &v1.SubmitCalculationRequest{
+		Add: &v1.AddRequest{Numbers: []float64{1, 2}},
+	})
+	require.NoError(t, err)
+
+	// A queued job is cancelled at once and never started
+	cancel, err := additionService.CancelJob(context.Background(), &v1.CancelJobRequest{JobId: queued.Job.JobId})
+	require.NoError(t, err)
+	assert.Equal(t, v1.JobStatus_JOB_STATUS_CANCELLED, cancel.Job.Status)
+	assert.Nil(t, cancel.Job.StartTime)
+	assert.Nil(t, cancel.Job.AddResponse)
+
+	// A running job is cancelled once it stops
+	_, err = additionService.CancelJob(context.Background(), &v1.CancelJobRequest{JobId: running.Job.JobId})
+	require.NoError(t, err)
+	wait, err := additionService.WaitJob(context.Background(), &v1.WaitJobRequest{JobId: running.Job.JobId})
+	require.NoError(t, err)
+	assert.Equal(t, v1.JobStatus_JOB_STATUS_CANCELLED, wait.Job.Status)
+	assert.NotNil(t, wait.Job.EndTime)
+
+	// Cancelling a finished job leaves it unchanged
+	cancel, err = additionService.CancelJob(context.Background(), &v1.CancelJobRequest{JobId: running.Job.JobId})
+	require.NoError(t, err)
+	assert.Equal(t, v1.JobStatus_JOB_STATUS_CANCELLED, cancel.Job.Status)
+}
+
+func TestAdditionService_JobPanic(t *testing.T) {
+	additionService := service.NewAdditionService()
+	defer additionService.Close()
+
+	// A nil addition cannot be performed and panics
+	failed, err := additionService.SubmitCalculation(context.Background(), &v1.SubmitCalculationRequest{
+		BatchAdd: &v1.BatchAddRequest{Requests: []*v1.AddRequest{nil}},
+	})
+	require.NoError(t, err)
+	wait, err := additionService.WaitJob(context.Background(), &v1.WaitJobRequest{JobId: failed.Job.JobId})
+	require.NoError(t, err)
+	assert.Equal(t, v1.JobStatus_JOB_STATUS_FAILED, wait.Job.Status)
+	assert.NotNil(t, wait.Job.EndTime)
+
+	// The worker survives and performs the next job
+	for range runtime.GOMAXPROCS(0) + 1 {
+		done, err := additionService.SubmitCalculation(context.Background(), &v1.SubmitCalculationRequest{
+			Add: &v1.AddRequest{Numbers: []float64{1, 2}},
+		})
+		require.NoError(t, err)
+		wait, err = additionService.WaitJob(context.Background(), &v1.WaitJobRequest{JobId: done.Job.JobId})
+		require.NoError(t, err)
+		assert.Equal(t, v1.JobStatus_JOB_STATUS_DONE, wait.Job.Status)
+	}
+}
+
+func TestAdditionService_WaitJobTimeout(t *testing.T) {
+	additionService := service.NewAdditionService()
+	defer additionService.Close()
+
+	submit, err := additionService.SubmitCalculation(context.Background(), &v1.SubmitCalculationRequest{
+		BatchAdd: largeBatch(),
+	})
+	require.NoError(t, err)
+
+	wait, err := additionService.WaitJob(context.Background(), &v1.WaitJobRequest{
+		JobId:     submit.Job.JobId,
+		TimeoutMs: 10,
+	})
+	require.NoError(t, err)
+	assert.Contains(t, []v1.JobStatus{v1.JobStatus_JOB_STATUS_QUEUED, v1.JobStatus_JOB_STATUS_RUNNING}, wait.Job.Status)
+	assert.Nil(t, wait.Job.EndTime)
+}
+
+func TestAdditionService_JobErrors(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	testCases := []struct {
+		name     string
+		call     func() (*v1.AddResponse_ErrorInfo, error)
+		expected string
+	}{
+		{
+			name: "NoCalculation",
+			call: func() (*v1.AddResponse_ErrorInfo, error) {
+				resp, err := additionService.SubmitCalculation(context.Background(), &v1.SubmitCalculationRequest{})
+				return resp.Error, err
+			},
+			expected: "INVALID_JOB",
+		},
+		{
+			name: "BothCalculations",
+			call: func() (*v1.AddResponse_ErrorInfo, error) {
+				resp, err := additionService.SubmitCalculation(context.Background(), &v1.SubmitCalculationRequest{
+					Add:      &v1.AddRequest{Numbers: []float64{1}},
+					BatchAdd: &v1.BatchAddRequest{Requests: []*v1.AddRequest{{Numbers: []float64{1}}}},
+				})
+				return resp.Error, err
+			},
+			expected: "INVALID_JOB",
+		},
+		{
+			name: "UnknownJob",
+			call: func() (*v1.AddResponse_ErrorInfo, error) {
+				resp, err := additionService.GetJob(context.Background(), &v1.GetJobRequest{JobId: "missing"})
+				return resp.Error, err
+			},
+			expected: "JOB_NOT_FOUND",
+		},
+		{
+			name: "CancelUnknownJob",
+			call: func() (*v1.AddResponse_ErrorInfo, error) {
+				resp, err := additionService.CancelJob(context.Background(), &v1.CancelJobRequest{JobId: "missing"})
+				return resp.Error, err
+			},
+			expected: "JOB_NOT_FOUND",
+		},
+		{
+			name: "NegativeTimeout",
+			call: func() (*v1.AddResponse_ErrorInfo, error) {
+				resp, err := additionService.WaitJob(context.Background(), &v1.WaitJobRequest{JobId: "missing", TimeoutMs: -1})
+				return resp.Error, err
+			},
+			expected: "INVALID_TIMEOUT",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			errInfo, err := tc.call()
+			assert.Error(t, err)
+			require.NotNil(t, errInfo)
+			assert.Equal(t, tc.expected, errInfo.Code)
+		})
+	}
+
+	// A closed service no longer accepts jobs
+	require.NoError(t, additionService.Close())
+	resp, err := additionService.SubmitCalculation(context.Background(), &v1.SubmitCalculationRequest{
+		Add: &v1.AddRequest{Numbers: []float64{1}},
+	})
+	assert.Error(t, err)
+	assert.Equal(t, "SERVICE_CLOSED", resp.Error.Code)
+}
@@ -0,0 +1,227 @@
+package calculation
+
+import (
+	"context"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/service"
+)
+
+func writeExchangeRates(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "rates.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestAdditionService_AddMoney(t *testing.T) {
+	additionService := service.NewAdditionService()
+	require.NoError(t, additionService.LoadExchangeRates(writeExchangeRates(t,
+		`{"base": "EUR", "rates": {"USD": "2", "JPY": "160"}}`)))
+
+	testCases := []struct {
+		name             string
+		request          *v1.AddRequest
+		expectedAmount   string
+		expectedCurrency string
+		expectedRounding v1.RoundingMode
+	}{
+		{
+			name: "Same Currency",
+			request: &v1.AddRequest{
+				MoneyNumbers: []*v1.Money{
+					{Currency: "EUR", Amount: "0.10"},
+					{Currency: "EUR", Amount: "0.20"},
+				},
+			},
+			expectedAmount:   "0.30",
+			expectedCurrency: "EUR",
+			expectedRounding: v1.RoundingMode_ROUNDING_MODE_HALF_EVEN,
+		},
+		{
+			name: "Zero Minor Units",
+			request: &v1.AddRequest{
+				MoneyNumbers: []*v1.Money{
+					{Currency: "jpy", Amount: "100"},
+					{Currency: "JPY", Amount: "250"},
+				},
+			},
+			expectedAmount:   "350",
+			expectedCurrency: "JPY",
+			expectedRounding: v1.RoundingMode_ROUNDING_MODE_HALF_EVEN,
+		},
+		{
+			name: "Converted Half Even",
+			request: &v1.AddRequest{
+				MoneyNumbers: []*v1.Money{
+					{Currency: "EUR", Amount: "1.00"},
+					{Currency: "USD", Amount: "0.01"},
+				},
+				MoneyOptions: &v1.AddRequest_MoneyOptions{ConvertCurrencies: true},
+			},
+			expectedAmount:   "1.00",
+			expectedCurrency: "EUR",
+			expectedRounding: v1.RoundingMode_ROUNDING_MODE_HALF_EVEN,
+		},
+		{
+			name: "Converted Half Up",
+			request: &v1.AddRequest{
+				MoneyNumbers: []*v1.Money{
+					{Currency: "EUR", Amount: "1.00"},
+					{Currency: "USD", Amount: "0.01"},
+				},
+				MoneyOptions: &v1.AddRequest_MoneyOptions{
+					ConvertCurrencies: true,
+					RoundingMode:      v1.RoundingMode_ROUNDING_MODE_HALF_UP,
+				},
+			},
+			expectedAmount:   "1.01",
+			expectedCurrency: "EUR",
+			expectedRounding: v1.RoundingMode_ROUNDING_MODE_HALF_UP,
+		},
+		{
+			name: "Converted Into Result Currency Down",
+			request: &v1.AddRequest{
+				MoneyNumbers: []*v1.Money{
+					{Currency: "EUR", Amount: "0.99"},
+					{Currency: "USD", Amount: "1.03"},
+				},
+				MoneyOptions: &v1.AddRequest_MoneyOptions{
+					Currency:          stringPtr("JPY"),
+					ConvertCurrencies: true,
+					RoundingMode:      v1.RoundingMode_ROUNDING_MODE_DOWN,
+				},
+			},
+			expectedAmount:   "240",
+			expectedCurrency: "JPY",
+			expectedRounding: v1.RoundingMode_ROUNDING_MODE_DOWN,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := additionService.Add(context.Background(), tc.request)
+
+			require.NoError(t, err)
+			require.NotNil(t, resp.MoneyResult)
+			assert.Equal(t, tc.expectedAmount, resp.MoneyResult.Amount)
+			assert.Equal(t, tc.expectedCurrency, resp.MoneyResult.Currency)
+			require.NotNil(t, resp.CalculationMetadata)
+			assert.Equal(t, "money", resp.CalculationMetadata.CalculationMethod)
+			assert.Equal(t, tc.expectedRounding, resp.CalculationMetadata.RoundingMode)
+		})
+	}
+}
+
+func TestAdditionService_AddMoneyErrors(t *testing.T) {
+	additionService := service.NewAdditionService()
+	require.NoError(t, additionService.LoadExchangeRates(writeExchangeRates(t,
+		`{"base": "EUR", "rates": {"USD": "1.25"}}`)))
+
+	testCases := []struct {
+		name         string
+		request      *v1.AddRequest
+		expectedCode string
+	}{
+		{
+			name: "Currency Mismatch",
+			request: &v1.AddRequest{
+				MoneyNumbers: []*v1.Money{
+					{Currency: "EUR", Amount: "1.00"},
+					{Currency: "USD", Amount: "1.00"},
+				},
+			},
+			expectedCode: "CURRENCY_MISMATCH",
+		},
+		{
+			name: "Missing Exchange Rate",
+			request: &v1.AddRequest{
+				MoneyNumbers: []*v1.Money{
+					{Currency: "EUR", Amount: "1.00"},
+					{Currency: "GBP", Amount: "1.00"},
+				},
+				MoneyOptions: &v1.AddRequest_MoneyOptions{ConvertCurrencies: true},
+			},
+			expectedCode: "MISSING_EXCHANGE_RATE",
+		},
+		{
+			name: "Unknown Currency",
+			request: &v1.AddRequest{
+				MoneyNumbers: []*v1.Money{{Currency: "XYZ", Amount: "1.00"}},
+			},
+			expectedCode: "UNKNOWN_CURRENCY",
+		},
+		{
+			name: "Too Many Fractional Digits",
+			request: &v1.AddRequest{
+				MoneyNumbers: []*v1.Money{{Currency: "EUR", Amount: "1.005"}},
+			},
+			expectedCode: "INVALID_AMOUNT",
+		},
+		{
+			name: "Invalid Amount",
+			request: &v1.AddRequest{
+				MoneyNumbers: []*v1.Money{{Currency: "EUR", Amount: "ten"}},
+			},
+			expectedCode: "INVALID_AMOUNT",
+		},
+		{
+			name: "Mixed With Numbers",
+			request: &v1.AddRequest{
+				Numbers:      []float64{1},
+				MoneyNumbers: []*v1.Money{{Currency: "EUR", Amount: "1.00"}},
+			},
+			expectedCode: "MIXED_OPERANDS",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := additionService.Add(context.Background(), tc.request)
+
+			require.Error(t, err)
+			require.NotNil(t, resp.Error)
+			assert.Equal(t, tc.expectedCode, resp.Error.Code)
+		})
+	}
+}
+
+func TestAdditionService_LoadExchangeRatesErrors(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	assert.Error(t, additionService.LoadExchangeRates(filepath.Join(t.TempDir(), "missing.json")))
+	assert.Error(t, additionService.LoadExchangeRates(writeExchangeRates(t, `{"base": "XYZ"}`)))
+	assert.Error(t, additionService.LoadExchangeRates(writeExchangeRates(t, `{"base": "EUR", "rates": {"USD": "-1"}}`)))
+}
+
+func TestAdditionService_AddMoneyNonFiniteBounds(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	resp, err := additionService.Add(context.Background(), &v1.AddRequest{
+		MoneyNumbers: []*v1.Money{{Currency: "EUR", Amount: "1.50"}, {Currency: "EUR", Amount: "2.25"}},
+		Constraints:  &v1.AddRequest_Constraints{MaxValue: floatPtr(math.Inf(1))},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "3.75", resp.MoneyResult.Amount)
+
+	resp, err = additionService.Add(context.Background(), &v1.AddRequest{
+		MoneyNumbers: []*v1.Money{{Currency: "EUR", Amount: "1.50"}},
+		Constraints:  &v1.AddRequest_Constraints{MinValue: floatPtr(math.Inf(1))},
+	})
+	require.Error(t, err)
+	assert.Equal(t, "VALUE_TOO_LOW", resp.Error.Code)
+
+	resp, err = additionService.Add(context.Background(), &v1.AddRequest{
+		MoneyNumbers: []*v1.Money{{Currency: "EUR", Amount: "1.50"}},
+		Constraints:  &v1.AddRequest_Constraints{MaxValue: floatPtr(math.NaN())},
+	})
+	require.Error(t, err)
+	assert.Equal(t, "INVALID_CONSTRAINT", resp.Error.Code)
+}
@@ -0,0 +1,139 @@
+package calculation
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/service"
+)
+
+func TestAdditionService_NonFinitePolicies(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	testCases := []struct {
+		name              string
+		numbers           []float64
+		constraints       *v1.AddRequest_Constraints
+		expectedResult    float64
+		expectedProcessed int32
+		expectedCode      string
+	}{
+		{
+			name:         "NaN Rejected By Default",
+			numbers:      []float64{1, math.NaN()},
+			expectedCode: "NAN_INPUT",
+		},
+		{
+			name:         "Infinity Rejected By Default",
+			numbers:      []float64{1, math.Inf(-1)},
+			expectedCode: "INFINITE_INPUT",
+		},
+		{
+			name:    "Explicit Reject",
+			numbers: []float64{math.Inf(1)},
+			constraints: &v1.AddRequest_Constraints{
+				NanPolicy:      v1.NonFinitePolicy_NON_FINITE_POLICY_SKIP,
+				InfinityPolicy: v1.NonFinitePolicy_NON_FINITE_POLICY_REJECT,
+			},
+			expectedCode: "INFINITE_INPUT",
+		},
+		{
+			name:    "Skip",
+			numbers: []float64{1, math.NaN(), 2, math.Inf(1)},
+			constraints: &v1.AddRequest_Constraints{
+				NanPolicy:      v1.NonFinitePolicy_NON_FINITE_POLICY_SKIP,
+				InfinityPolicy: v1.NonFinitePolicy_NON_FINITE_POLICY_SKIP,
+			},
+			expectedResult:    3,
+			expectedProcessed: 2,
+		},
+		{
+			name:    "Skip Everything",
+			numbers: []float64{math.NaN(), math.NaN()},
+			constraints: &v1.AddRequest_Constraints{
+				NanPolicy: v1.NonFinitePolicy_NON_FINITE_POLICY_SKIP,
+			},
+			expectedCode: "NO_NUMBERS",
+		},
+		{
+			name:    "Propagate Infinity",
+			numbers: []float64{1, math.Inf(1)},
+			constraints: &v1.AddRequest_Constraints{
+				InfinityPolicy: v1.NonFinitePolicy_NON_FINITE_POLICY_PROPAGATE,
+			},
+			expectedResult:    math.Inf(1),
+			expectedProcessed: 2,
+		},
+		{
+			name:    "Propagate NaN",
+			numbers: []float64{1, math.NaN()},
+			constraints: &v1.AddRequest_Constraints{
+				NanPolicy: v1.NonFinitePolicy_NON_FINITE_POLICY_PROPAGATE,
+			},
+			expectedResult:    math.NaN(),
+			expectedProcessed: 2,
+		},
+		{
+			name:    "Propagated Infinity Still Range Checked",
+			numbers: []float64{1, math.Inf(1)},
+			constraints: &v1.AddRequest_Constraints{
+				MaxValue:       floatPtr(100),
+				InfinityPolicy: v1.NonFinitePolicy_NON_FINITE_POLICY_PROPAGATE,
+			},
+			expectedCode: "VALUE_TOO_HIGH",
+		},
+		{
+			name:         "Finite Overflow",
+			numbers:      []float64{math.MaxFloat64, math.MaxFloat64},
+			expectedCode: "OVERFLOW",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := additionService.Add(context.Background(), &v1.AddRequest{
+				Numbers:     tc.numbers,
+				Constraints: tc.constraints,
+			})
+
+			if tc.expectedCode != "" {
+				require.Error(t, err)
+				require.NotNil(t, resp.Error)
+				assert.Equal(t, tc.expectedCode, resp.Error.Code)
+				return
+			}
+
+			require.NoError(t, err)
+			if math.IsNaN(tc.expectedResult) {
+				assert.True(t, math.IsNaN(resp.Result))
+			} else {
+				assert.Equal(t, tc.expectedResult, resp.Result)
+			}
+			require.NotNil(t, resp.CalculationMetadata)
+			assert.Equal(t, tc.expectedProcessed, resp.CalculationMetadata.NumbersProcessed)
+		})
+	}
+}
+
+func TestAdditionService_NonFinitePoliciesArithmetic(t *testing.T) {
+	additionService := service.NewAdditionService()
+	skipNaN := &v1.AddRequest_Constraints{NanPolicy: v1.NonFinitePolicy_NON_FINITE_POLICY_SKIP}
+
+	multiplyResp, err := additionService.Multiply(context.Background(), &v1.MultiplyRequest{
+		Numbers:     []float64{2, math.NaN(), 3},
+		Constraints: skipNaN,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 6.0, multiplyResp.Result)
+
+	subtractResp, err := additionService.Subtract(context.Background(), &v1.SubtractRequest{
+		Numbers: []float64{math.NaN(), 3},
+	})
+	require.Error(t, err)
+	assert.Equal(t, "NAN_INPUT", subtractResp.Error.Code)
+}
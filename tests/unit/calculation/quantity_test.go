@@ -0,0 +1,158 @@
+package calculation
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/service"
+)
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func TestAdditionService_AddQuantities(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	testCases := []struct {
+		name           string
+		request        *v1.AddRequest
+		expectedResult float64
+		expectedUnit   string
+	}{
+		{
+			name: "Defaults To First Unit",
+			request: &v1.AddRequest{
+				Numbers: []float64{1.5, 250, 20},
+				Units:   []string{"km", "m", "cm"},
+			},
+			expectedResult: 1.7502,
+			expectedUnit:   "km",
+		},
+		{
+			name: "Explicit Result Unit",
+			request: &v1.AddRequest{
+				Numbers:    []float64{1, 500},
+				Units:      []string{"s", "ms"},
+				ResultUnit: stringPtr("ms"),
+			},
+			expectedResult: 1500,
+			expectedUnit:   "ms",
+		},
+		{
+			name: "Imperial Mass",
+			request: &v1.AddRequest{
+				Numbers:    []float64{1, 16},
+				Units:      []string{"lb", "oz"},
+				ResultUnit: stringPtr("kg"),
+			},
+			expectedResult: 0.90718474,
+			expectedUnit:   "kg",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := additionService.Add(context.Background(), tc.request)
+
+			require.NoError(t, err)
+			assert.InDelta(t, tc.expectedResult, resp.Result, 1e-9)
+			assert.Equal(t, tc.expectedUnit, resp.Unit)
+			require.NotNil(t, resp.CalculationMetadata)
+			assert.Equal(t, int32(len(tc.request.Numbers)), resp.CalculationMetadata.NumbersProcessed)
+		})
+	}
+}
+
+func TestAdditionService_AddQuantitiesErrors(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	testCases := []struct {
+		name         string
+		request      *v1.AddRequest
+		expectedCode string
+	}{
+		{
+			name: "Incompatible Units",
+			request: &v1.AddRequest{
+				Numbers: []float64{1, 2},
+				Units:   []string{"m", "kg"},
+			},
+			expectedCode: "UNIT_MISMATCH",
+		},
+		{
+			name: "Incompatible Result Unit",
+			request: &v1.AddRequest{
+				Numbers:    []float64{1, 2},
+				Units:      []string{"s", "ms"},
+				ResultUnit: stringPtr("m"),
+			},
+			expectedCode: "UNIT_MISMATCH",
+		},
+		{
+			name: "Unknown Unit",
+			request: &v1.AddRequest{
+				Numbers: []float64{1, 2},
+				Units:   []string{"m", "parsec"},
+			},
+			expectedCode: "UNKNOWN_UNIT",
+		},
+		{
+			name: "Missing Units",
+			request: &v1.AddRequest{
+				Numbers: []float64{1, 2, 3},
+				Units:   []string{"m", "m"},
+			},
+			expectedCode: "INVALID_UNITS",
+		},
+		{
+			name: "Conversion Overflow",
+			request: &v1.AddRequest{
+				Numbers:    []float64{1e306},
+				Units:      []string{"km"},
+				ResultUnit: stringPtr("m"),
+			},
+			expectedCode: "OVERFLOW",
+		},
+		{
+			name: "Units On Integers",
+			request: &v1.AddRequest{
+				IntegerNumbers: []int64{1, 2},
+				Units:          []string{"m", "m"},
+			},
+			expectedCode: "INVALID_UNITS",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := additionService.Add(context.Background(), tc.request)
+
+			require.Error(t, err)
+			require.NotNil(t, resp.Error)
+			assert.Equal(t, tc.expectedCode, resp.Error.Code)
+		})
+	}
+}
+
+func TestAdditionService_AddQuantitiesPropagatesInfinity(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	resp, err := additionService.Add(context.Background(), &v1.AddRequest{
+		Numbers:    []float64{math.Inf(1), 2},
+		Units:      []string{"km", "m"},
+		ResultUnit: stringPtr("m"),
+		Constraints: &v1.AddRequest_Constraints{
+			InfinityPolicy: v1.NonFinitePolicy_NON_FINITE_POLICY_PROPAGATE,
+		},
+	})
+
+	require.NoError(t, err)
+	assert.True(t, math.IsInf(resp.Result, 1))
+	assert.Equal(t, "m", resp.Unit)
+}
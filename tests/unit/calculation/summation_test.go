@@ -0,0 +1,222 @@
+package calculation
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/service"
+)
+
+func TestAdditionService_SummationAlgorithms(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	// Many small values added to a large one are lost by naive summation
+	manySmall := []float64{1e16}
+	for i := 0; i < 1000; i++ {
+		manySmall = append(manySmall, 1.0)
+	}
+
+	sequence := make([]float64, 100)
+	for i := range sequence {
+		sequence[i] = float64(i + 1)
+	}
+
+	testCases := []struct {
+		name           string
+		numbers        []float64
+		algorithm      v1.SummationAlgorithm
+		expectedResult float64
+		expectedMethod string
+	}{
+		{
+			name:           "Default Is Naive",
+			numbers:        []float64{1.0, 2.0, 3.0},
+			expectedResult: 6.0,
+			expectedMethod: "simple_addition",
+		},
+		{
+			name:           "Naive Loses Cancellation",
+			numbers:        []float64{1e16, 1.0, -1e16},
+			algorithm:      v1.SummationAlgorithm_SUMMATION_ALGORITHM_NAIVE,
+			expectedResult: 0.0,
+			expectedMethod: "simple_addition",
+		},
+		{
+			name:           "Neumaier Cancellation",
+			numbers:        []float64{1e16, 1.0, -1e16},
+			algorithm:      v1.SummationAlgorithm_SUMMATION_ALGORITHM_NEUMAIER,
+			expectedResult: 1.0,
+			expectedMethod: "neumaier_summation",
+		},
+		{
+			name:           "Pairwise",
+			numbers:        sequence,
+			algorithm:      v1.SummationAlgorithm_SUMMATION_ALGORITHM_PAIRWISE,
+			expectedResult: 5050.0,
+			expectedMethod: "pairwise_summation",
+		},
+		{
+			name:           "Parallel",
+			numbers:        sequence,
+			algorithm:      v1.SummationAlgorithm_SUMMATION_ALGORITHM_PARALLEL,
+			expectedResult: 5050.0,
+			expectedMethod: "parallel_pairwise_summation",
+		},
+		{
+			name:           "Kahan Many Small Values",
+			numbers:        manySmall,
+			algorithm:      v1.SummationAlgorithm_SUMMATION_ALGORITHM_KAHAN,
+			expectedResult: 1e16 + 1000,
+			expectedMethod: "kahan_summation",
+		},
+		{
+			name:           "Neumaier Many Small Values",
+			numbers:        manySmall,
+			algorithm:      v1.SummationAlgorithm_SUMMATION_ALGORITHM_NEUMAIER,
+			expectedResult: 1e16 + 1000,
+			expectedMethod: "neumaier_summation",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := additionService.Add(context.Background(), &v1.AddRequest{
+				Numbers:   tc.numbers,
+				Algorithm: tc.algorithm,
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedResult, resp.Result)
+			require.NotNil(t, resp.CalculationMetadata)
+			assert.Equal(t, tc.expectedMethod, resp.CalculationMetadata.CalculationMethod)
+		})
+	}
+}
+
+func TestAdditionService_ParallelSummationReproducible(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	// Several blocks of values whose sum depends on the order of addition
+	rng := rand.New(rand.NewSource(42))
+	numbers := make([]float64, 1_000_000)
+	for i := range numbers {
+		numbers[i] = rng.NormFloat64() * math.Pow(10, float64(rng.Intn(12)))
+	}
+
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(0))
+
+	var results []float64
+	for _, procs := range []int{1, 2, 3, 8} {
+		runtime.GOMAXPROCS(procs)
+		resp, err := additionService.Add(context.Background(), &v1.AddRequest{
+			Numbers:   numbers,
+			Algorithm: v1.SummationAlgorithm_SUMMATION_ALGORITHM_PARALLEL,
+		})
+		require.NoError(t, err)
+		results = append(results, resp.Result)
+	}
+
+	for _, result := range results[1:] {
+		assert.Equal(t, math.Float64bits(results[0]), math.Float64bits(result))
+	}
+
+	exact, err := additionService.Add(context.Background(), &v1.AddRequest{
+		Numbers:   numbers,
+		Algorithm: v1.SummationAlgorithm_SUMMATION_ALGORITHM_NEUMAIER,
+	})
+	require.NoError(t, err)
+	assert.InEpsilon(t, exact.Result, results[0], 1e-9)
+}
+
+func TestAdditionService_DefaultSummationOfLargeInputs(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	rng := rand.New(rand.NewSource(7))
+	numbers := make([]float64, 1<<20)
+	for i := range numbers {
+		numbers[i] = rng.NormFloat64() * math.Pow(10, float64(rng.Intn(12)))
+	}
+
+	// Smaller inputs are added left to right
+	small, err := additionService.Add(context.Background(), &v1.AddRequest{Numbers: numbers[:len(numbers)-1]})
+	require.NoError(t, err)
+	assert.Equal(t, "simple_addition", small.CalculationMetadata.CalculationMethod)
+
+	// Large inputs are added in parallel, with the same result on any number
+	// of CPUs
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(0))
+	parallel, err := additionService.Add(context.Background(), &v1.AddRequest{
+		Numbers:   numbers,
+		Algorithm: v1.SummationAlgorithm_SUMMATION_ALGORITHM_PARALLEL,
+	})
+	require.NoError(t, err)
+	for _, procs := range []int{1, 4} {
+		runtime.GOMAXPROCS(procs)
+		resp, err := additionService.Add(context.Background(), &v1.AddRequest{Numbers: numbers})
+		require.NoError(t, err)
+		assert.Equal(t, "parallel_pairwise_summation", resp.CalculationMetadata.CalculationMethod)
+		assert.Equal(t, math.Float64bits(parallel.Result), math.Float64bits(resp.Result))
+	}
+
+	// An explicitly requested algorithm is kept
+	naive, err := additionService.Add(context.Background(), &v1.AddRequest{
+		Numbers:   numbers,
+		Algorithm: v1.SummationAlgorithm_SUMMATION_ALGORITHM_NAIVE,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "simple_addition", naive.CalculationMetadata.CalculationMethod)
+}
+
+func TestAdditionService_InvalidSummationAlgorithm(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	resp, err := additionService.Add(context.Background(), &v1.AddRequest{
+		Numbers:   []float64{1.0, 2.0},
+		Algorithm: v1.SummationAlgorithm(99),
+	})
+
+	require.Error(t, err)
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, "INVALID_ALGORITHM", resp.Error.Code)
+}
+
+func TestAdditionService_SummationCancelled(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	numbers := make([]float64, 1<<18)
+	for i := range numbers {
+		numbers[i] = 1
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for name, algorithm := range v1.SummationAlgorithm_value {
+		t.Run(name, func(t *testing.T) {
+			requestID := "cancelled-" + name
+			resp, err := additionService.Add(ctx, &v1.AddRequest{
+				RequestId: requestID,
+				Numbers:   numbers,
+				Algorithm: v1.SummationAlgorithm(algorithm),
+			})
+			require.ErrorIs(t, err, context.Canceled)
+			require.NotNil(t, resp.Error)
+			assert.Equal(t, "REQUEST_CANCELLED", resp.Error.Code)
+
+			// The cancelled response is not kept for retries
+			resp, err = additionService.Add(context.Background(), &v1.AddRequest{
+				RequestId: requestID,
+				Numbers:   numbers,
+				Algorithm: v1.SummationAlgorithm(algorithm),
+			})
+			require.NoError(t, err)
+			assert.Equal(t, float64(len(numbers)), resp.Result)
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package calculation
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
+	"github.com/yourusername/proto-buf-experiment/services/calculation/service"
+)
+
+func TestAdditionService_CollectAllViolations(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	resp, err := additionService.Add(context.Background(), &v1.AddRequest{
+		Numbers: []float64{5, -20, 50, math.NaN(), 150},
+		Constraints: &v1.AddRequest_Constraints{
+			MinValue:       floatPtr(-10),
+			MaxValue:       floatPtr(100),
+			MaxNumbers:     intPtr(4),
+			ValidationMode: v1.ValidationMode_VALIDATION_MODE_COLLECT_ALL,
+		},
+	})
+
+	require.Error(t, err)
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, "CONSTRAINT_VIOLATIONS", resp.Error.Code)
+
+	expected := []struct {
+		index int32
+		rule  string
+		code  string
+	}{
+		{index: 4, rule: "max_numbers", code: "CONSTRAINT_VIOLATION"},
+		{index: 1, rule: "min_value", code: "VALUE_TOO_LOW"},
+		{index: 3, rule: "nan_policy", code: "NAN_INPUT"},
+		{index: 4, rule: "max_value", code: "VALUE_TOO_HIGH"},
+	}
+	require.Len(t, resp.Error.Violations, len(expected))
+	for i, violation := range resp.Error.Violations {
+		assert.Equal(t, expected[i].index, violation.Index)
+		assert.Equal(t, expected[i].rule, violation.Rule)
+		assert.Equal(t, expected[i].code, violation.Code)
+		assert.NotEmpty(t, violation.Message)
+	}
+	assert.Equal(t, -20.0, resp.Error.Violations[1].Value)
+	assert.True(t, math.IsNaN(resp.Error.Violations[2].Value))
+}
+
+func TestAdditionService_ValidationModes(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	testCases := []struct {
+		name               string
+		request            *v1.AddRequest
+		expectedCode       string
+		expectedViolations int
+	}{
+		{
+			name: "Fail Fast Reports First Violation",
+			request: &v1.AddRequest{
+				Numbers: []float64{5, -20, 150},
+				Constraints: &v1.AddRequest_Constraints{
+					MinValue: floatPtr(-10),
+					MaxValue: floatPtr(100),
+				},
+			},
+			expectedCode: "VALUE_TOO_LOW",
+		},
+		{
+			name: "Empty Input Before Max Numbers",
+			request: &v1.AddRequest{
+				Constraints: &v1.AddRequest_Constraints{
+					MaxNumbers: intPtr(-1),
+				},
+			},
+			expectedCode: "NO_NUMBERS",
+		},
+		{
+			name: "Collect All Single Violation",
+			request: &v1.AddRequest{
+				Numbers: []float64{5, 150},
+				Constraints: &v1.AddRequest_Constraints{
+					MaxValue:       floatPtr(100),
+					ValidationMode: v1.ValidationMode_VALIDATION_MODE_COLLECT_ALL,
+				},
+			},
+			expectedCode:       "CONSTRAINT_VIOLATIONS",
+			expectedViolations: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp, err := additionService.Add(context.Background(), tc.request)
+
+			require.Error(t, err)
+			require.NotNil(t, resp.Error)
+			assert.Equal(t, tc.expectedCode, resp.Error.Code)
+			assert.Len(t, resp.Error.Violations, tc.expectedViolations)
+		})
+	}
+}
+
+func TestAdditionService_CollectAllViolationsSubtract(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	resp, err := additionService.Subtract(context.Background(), &v1.SubtractRequest{
+		Numbers: []float64{-50, 1, -60},
+		Constraints: &v1.AddRequest_Constraints{
+			MinValue:       floatPtr(0),
+			ValidationMode: v1.ValidationMode_VALIDATION_MODE_COLLECT_ALL,
+		},
+	})
+
+	require.Error(t, err)
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, "CONSTRAINT_VIOLATIONS", resp.Error.Code)
+	require.Len(t, resp.Error.Violations, 2)
+	assert.Equal(t, int32(0), resp.Error.Violations[0].Index)
+	assert.Equal(t, int32(2), resp.Error.Violations[1].Index)
+}
+
+func TestAdditionService_CollectAllViolationsCapped(t *testing.T) {
+	additionService := service.NewAdditionService()
+
+	numbers := make([]float64, 5000)
+	for i := range numbers {
+		numbers[i] = -1
+	}
+	resp, err := additionService.Add(context.Background(), &v1.AddRequest{
+		Numbers: numbers,
+		Constraints: &v1.AddRequest_Constraints{
+			NonNegative:    true,
+			ValidationMode: v1.ValidationMode_VALIDATION_MODE_COLLECT_ALL,
+		},
+	})
+
+	require.Error(t, err)
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, "CONSTRAINT_VIOLATIONS", resp.Error.Code)
+	assert.Len(t, resp.Error.Violations, 1000)
+	assert.Equal(t, "Found 5000 constraint violations, listing the first 1000", resp.Error.Message)
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"io/ioutil"
+	"math"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -14,6 +15,10 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	v1 "github.com/yourusername/proto-buf-experiment/gen/go/calculator/v1"
@@ -32,6 +37,100 @@ func (m *MockAdditionServiceClient) Add(ctx context.Context, in *v1.AddRequest,
 	return args.Get(0).(*v1.AddResponse), args.Error(1)
 }
 
+func (m *MockAdditionServiceClient) BatchAdd(ctx context.Context, in *v1.BatchAddRequest, opts ...grpc.CallOption) (*v1.BatchAddResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*v1.BatchAddResponse), args.Error(1)
+}
+
+func (m *MockAdditionServiceClient) AddVectors(ctx context.Context, in *v1.AddVectorsRequest, opts ...grpc.CallOption) (*v1.AddVectorsResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*v1.AddVectorsResponse), args.Error(1)
+}
+
+func (m *MockAdditionServiceClient) AddMatrices(ctx context.Context, in *v1.AddMatricesRequest, opts ...grpc.CallOption) (*v1.AddMatricesResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*v1.AddMatricesResponse), args.Error(1)
+}
+
+func (m *MockAdditionServiceClient) AddStream(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[v1.AddStreamRequest, v1.AddResponse], error) {
+	args := m.Called(ctx, opts)
+	return args.Get(0).(grpc.ClientStreamingClient[v1.AddStreamRequest, v1.AddResponse]), args.Error(1)
+}
+
+func (m *MockAdditionServiceClient) AddProgress(ctx context.Context, in *v1.AddProgressRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[v1.AddResponse], error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(grpc.ServerStreamingClient[v1.AddResponse]), args.Error(1)
+}
+
+func (m *MockAdditionServiceClient) Session(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[v1.SessionRequest, v1.SessionResponse], error) {
+	args := m.Called(ctx, opts)
+	return args.Get(0).(grpc.BidiStreamingClient[v1.SessionRequest, v1.SessionResponse]), args.Error(1)
+}
+
+func (m *MockAdditionServiceClient) Subtract(ctx context.Context, in *v1.SubtractRequest, opts ...grpc.CallOption) (*v1.SubtractResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*v1.SubtractResponse), args.Error(1)
+}
+
+func (m *MockAdditionServiceClient) Multiply(ctx context.Context, in *v1.MultiplyRequest, opts ...grpc.CallOption) (*v1.MultiplyResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*v1.MultiplyResponse), args.Error(1)
+}
+
+func (m *MockAdditionServiceClient) Divide(ctx context.Context, in *v1.DivideRequest, opts ...grpc.CallOption) (*v1.DivideResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*v1.DivideResponse), args.Error(1)
+}
+
+func (m *MockAdditionServiceClient) Evaluate(ctx context.Context, in *v1.EvaluateRequest, opts ...grpc.CallOption) (*v1.EvaluateResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*v1.EvaluateResponse), args.Error(1)
+}
+
+func (m *MockAdditionServiceClient) Describe(ctx context.Context, in *v1.DescribeRequest, opts ...grpc.CallOption) (*v1.DescribeResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*v1.DescribeResponse), args.Error(1)
+}
+
+func (m *MockAdditionServiceClient) ListCalculations(ctx context.Context, in *v1.ListCalculationsRequest, opts ...grpc.CallOption) (*v1.ListCalculationsResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*v1.ListCalculationsResponse), args.Error(1)
+}
+
+func (m *MockAdditionServiceClient) GetCalculation(ctx context.Context, in *v1.GetCalculationRequest, opts ...grpc.CallOption) (*v1.GetCalculationResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*v1.GetCalculationResponse), args.Error(1)
+}
+
+func (m *MockAdditionServiceClient) SubmitCalculation(ctx context.Context, in *v1.SubmitCalculationRequest, opts ...grpc.CallOption) (*v1.SubmitCalculationResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*v1.SubmitCalculationResponse), args.Error(1)
+}
+
+func (m *MockAdditionServiceClient) GetJob(ctx context.Context, in *v1.GetJobRequest, opts ...grpc.CallOption) (*v1.GetJobResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*v1.GetJobResponse), args.Error(1)
+}
+
+func (m *MockAdditionServiceClient) CancelJob(ctx context.Context, in *v1.CancelJobRequest, opts ...grpc.CallOption) (*v1.CancelJobResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*v1.CancelJobResponse), args.Error(1)
+}
+
+func (m *MockAdditionServiceClient) WaitJob(ctx context.Context, in *v1.WaitJobRequest, opts ...grpc.CallOption) (*v1.WaitJobResponse, error) {
+	args := m.Called(ctx, in, opts)
+	return args.Get(0).(*v1.WaitJobResponse), args.Error(1)
+}
+
+// grpcError builds the error the gRPC client returns for a failed call, with
+// the response the calculation service attaches to the status
+func grpcError(t *testing.T, message string, response protoadapt.MessageV1) error {
+	t.Helper()
+	st, err := status.New(codes.Unknown, message).WithDetails(response)
+	require.NoError(t, err)
+	return st.Err()
+}
+
 func TestAddHandler(t *testing.T) {
 	testCases := []struct {
 		name            string
@@ -162,6 +261,1106 @@ func TestAddHandler(t *testing.T) {
 	}
 }
 
+func TestAddHandler_Units(t *testing.T) {
+	resultUnit := "m"
+	mockClient := new(MockAdditionServiceClient)
+	mockClient.On("Add", mock.Anything, &v1.AddRequest{
+		Numbers:    []float64{1.0, 50.0},
+		Units:      []string{"km", "cm"},
+		ResultUnit: &resultUnit,
+	}, mock.Anything).Return(&v1.AddResponse{
+		Result:    1000.5,
+		Unit:      "m",
+		RequestId: "test-request-id",
+		CalculationMetadata: &v1.AddResponse_CalculationMetadata{
+			CalculationTime:   timestamppb.New(time.Now()),
+			NumbersProcessed:  2,
+			CalculationMethod: "simple_addition",
+		},
+	}, nil)
+
+	logger := logging.NewLogger(logging.LogConfig{
+		ServiceName: "web-handler",
+		Debug:       true,
+		WriteToFile: true,
+	})
+	handler := webhandler.NewWebHandler(mockClient, logger)
+
+	jsonBody, err := json.Marshal(webhandler.AddRequest{
+		Numbers:    []float64{1.0, 50.0},
+		Units:      []string{"km", "cm"},
+		ResultUnit: &resultUnit,
+	})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/add", bytes.NewBuffer(jsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.AddHandler(w, req)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var addResp webhandler.AddResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&addResp))
+	assert.Equal(t, 1000.5, addResp.Result)
+	assert.Equal(t, "m", addResp.Unit)
+	mockClient.AssertExpectations(t)
+}
+
+func TestAddHandler_Money(t *testing.T) {
+	currency := "EUR"
+	mockClient := new(MockAdditionServiceClient)
+	mockClient.On("Add", mock.Anything, &v1.AddRequest{
+		MoneyNumbers: []*v1.Money{
+			{Currency: "EUR", Amount: "1.00"},
+			{Currency: "USD", Amount: "0.01"},
+		},
+		MoneyOptions: &v1.AddRequest_MoneyOptions{
+			Currency:          &currency,
+			ConvertCurrencies: true,
+			RoundingMode:      v1.RoundingMode_ROUNDING_MODE_HALF_UP,
+		},
+	}, mock.Anything).Return(&v1.AddResponse{
+		Result:      1.01,
+		MoneyResult: &v1.Money{Currency: "EUR", Amount: "1.01"},
+		RequestId:   "test-request-id",
+		CalculationMetadata: &v1.AddResponse_CalculationMetadata{
+			CalculationTime:   timestamppb.New(time.Now()),
+			NumbersProcessed:  2,
+			CalculationMethod: "money",
+			RoundingMode:      v1.RoundingMode_ROUNDING_MODE_HALF_UP,
+		},
+	}, nil)
+
+	logger := logging.NewLogger(logging.LogConfig{
+		ServiceName: "web-handler",
+		Debug:       true,
+		WriteToFile: true,
+	})
+	handler := webhandler.NewWebHandler(mockClient, logger)
+
+	jsonBody, err := json.Marshal(webhandler.AddRequest{
+		Money: []webhandler.Money{
+			{Currency: "EUR", Amount: "1.00"},
+			{Currency: "USD", Amount: "0.01"},
+		},
+		MoneyOptions: &webhandler.MoneyOptions{
+			Currency:          &currency,
+			ConvertCurrencies: true,
+			RoundingMode:      "half_up",
+		},
+	})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/add", bytes.NewBuffer(jsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.AddHandler(w, req)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var addResp webhandler.AddResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&addResp))
+	require.NotNil(t, addResp.MoneyResult)
+	assert.Equal(t, "EUR", addResp.MoneyResult.Currency)
+	assert.Equal(t, "1.01", addResp.MoneyResult.Amount)
+	require.NotNil(t, addResp.CalculationMetadata)
+	assert.Equal(t, "half_up", addResp.CalculationMetadata.RoundingMode)
+	mockClient.AssertExpectations(t)
+}
+
+func TestAddHandler_Fractions(t *testing.T) {
+	mockClient := new(MockAdditionServiceClient)
+	mockClient.On("Add", mock.Anything, &v1.AddRequest{
+		FractionNumbers: []*v1.Fraction{
+			{Numerator: "1", Denominator: "3"},
+			{Numerator: "1", Denominator: "6"},
+		},
+	}, mock.Anything).Return(&v1.AddResponse{
+		Result:         0.5,
+		FractionResult: &v1.Fraction{Numerator: "1", Denominator: "2"},
+		RequestId:      "test-request-id",
+		CalculationMetadata: &v1.AddResponse_CalculationMetadata{
+			CalculationTime:   timestamppb.New(time.Now()),
+			NumbersProcessed:  2,
+			CalculationMethod: "rational",
+		},
+	}, nil)
+
+	logger := logging.NewLogger(logging.LogConfig{
+		ServiceName: "web-handler",
+		Debug:       true,
+		WriteToFile: true,
+	})
+	handler := webhandler.NewWebHandler(mockClient, logger)
+
+	body := `{"fractions": [{"numerator": "1", "denominator": "3"}, {"numerator": "1", "denominator": "6"}]}`
+	req := httptest.NewRequest(http.MethodPost, "/add", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.AddHandler(w, req)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var addResp webhandler.AddResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&addResp))
+	require.NotNil(t, addResp.FractionResult)
+	assert.Equal(t, "1", addResp.FractionResult.Numerator)
+	assert.Equal(t, "2", addResp.FractionResult.Denominator)
+	assert.Equal(t, 0.5, addResp.Result)
+	mockClient.AssertExpectations(t)
+}
+
+func TestAddHandler_Complex(t *testing.T) {
+	mockClient := new(MockAdditionServiceClient)
+	mockClient.On("Add", mock.Anything, &v1.AddRequest{
+		ComplexNumbers: []*v1.Complex{
+			{Re: 1, Im: 2},
+			{Re: 3, Im: -5},
+		},
+	}, mock.Anything).Return(&v1.AddResponse{
+		ComplexResult: &v1.Complex{Re: 4, Im: -3},
+		RequestId:     "test-request-id",
+		CalculationMetadata: &v1.AddResponse_CalculationMetadata{
+			CalculationTime:   timestamppb.New(time.Now()),
+			NumbersProcessed:  2,
+			CalculationMethod: "simple_addition",
+		},
+	}, nil)
+
+	logger := logging.NewLogger(logging.LogConfig{
+		ServiceName: "web-handler",
+		Debug:       true,
+		WriteToFile: true,
+	})
+	handler := webhandler.NewWebHandler(mockClient, logger)
+
+	body := `{"complex": [{"re": 1, "im": 2}, {"re": 3, "im": -5}]}`
+	req := httptest.NewRequest(http.MethodPost, "/add", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.AddHandler(w, req)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var addResp webhandler.AddResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&addResp))
+	require.NotNil(t, addResp.ComplexResult)
+	assert.Equal(t, webhandler.Complex{Re: 4, Im: -3}, *addResp.ComplexResult)
+	mockClient.AssertExpectations(t)
+}
+
+func TestAddHandler_NonFiniteComplex(t *testing.T) {
+	mockClient := new(MockAdditionServiceClient)
+	mockClient.On("Add", mock.Anything, mock.MatchedBy(func(req *v1.AddRequest) bool {
+		return len(req.ComplexNumbers) == 2 &&
+			math.IsNaN(req.ComplexNumbers[1].Re) &&
+			math.IsInf(req.ComplexNumbers[1].Im, -1)
+	}), mock.Anything).Return(&v1.AddResponse{
+		ComplexResult: &v1.Complex{Re: math.NaN(), Im: math.Inf(-1)},
+		RequestId:     "test-request-id",
+	}, nil)
+
+	logger := logging.NewLogger(logging.LogConfig{
+		ServiceName: "web-handler",
+		Debug:       true,
+		WriteToFile: true,
+	})
+	handler := webhandler.NewWebHandler(mockClient, logger)
+
+	body := `{"complex": [{"re": 1, "im": 2}, {"re": "NaN", "im": "-Infinity"}], "nan_policy": "propagate", "infinity_policy": "propagate"}`
+	req := httptest.NewRequest(http.MethodPost, "/add", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.AddHandler(w, req)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(respBody), `"complex_result":{"re":"NaN","im":"-Infinity"}`)
+	mockClient.AssertExpectations(t)
+}
+
+func TestAddHandler_Intervals(t *testing.T) {
+	value := 10.0
+	mockClient := new(MockAdditionServiceClient)
+	mockClient.On("Add", mock.Anything, &v1.AddRequest{
+		IntervalNumbers: []*v1.Interval{
+			{Lo: 1, Hi: 2},
+			{Value: &value, Uncertainty: 0.5},
+		},
+	}, mock.Anything).Return(&v1.AddResponse{
+		Result:         11.5,
+		IntervalResult: &v1.Interval{Lo: 10.5, Hi: 12.5},
+		RequestId:      "test-request-id",
+		CalculationMetadata: &v1.AddResponse_CalculationMetadata{
+			CalculationTime:   timestamppb.New(time.Now()),
+			NumbersProcessed:  2,
+			CalculationMethod: "interval_arithmetic",
+		},
+	}, nil)
+
+	logger := logging.NewLogger(logging.LogConfig{
+		ServiceName: "web-handler",
+		Debug:       true,
+		WriteToFile: true,
+	})
+	handler := webhandler.NewWebHandler(mockClient, logger)
+
+	body := `{"intervals": [{"lo": 1, "hi": 2}, {"value": 10, "uncertainty": 0.5}]}`
+	req := httptest.NewRequest(http.MethodPost, "/add", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.AddHandler(w, req)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var addResp webhandler.AddResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&addResp))
+	require.NotNil(t, addResp.IntervalResult)
+	assert.Equal(t, 10.5, addResp.IntervalResult.Lo)
+	assert.Equal(t, 12.5, addResp.IntervalResult.Hi)
+	mockClient.AssertExpectations(t)
+}
+
+func TestAddHandler_NonFiniteNumbers(t *testing.T) {
+	mockClient := new(MockAdditionServiceClient)
+	mockClient.On("Add", mock.Anything, mock.MatchedBy(func(req *v1.AddRequest) bool {
+		return len(req.Numbers) == 3 &&
+			req.Numbers[0] == 1 &&
+			math.IsNaN(req.Numbers[1]) &&
+			math.IsInf(req.Numbers[2], -1) &&
+			req.Constraints.GetNanPolicy() == v1.NonFinitePolicy_NON_FINITE_POLICY_PROPAGATE &&
+			req.Constraints.GetInfinityPolicy() == v1.NonFinitePolicy_NON_FINITE_POLICY_SKIP
+	}), mock.Anything).Return(&v1.AddResponse{
+		Result:    math.NaN(),
+		RequestId: "test-request-id",
+		CalculationMetadata: &v1.AddResponse_CalculationMetadata{
+			CalculationTime:   timestamppb.New(time.Now()),
+			NumbersProcessed:  2,
+			CalculationMethod: "simple_addition",
+		},
+	}, nil)
+
+	logger := logging.NewLogger(logging.LogConfig{
+		ServiceName: "web-handler",
+		Debug:       true,
+		WriteToFile: true,
+	})
+	handler := webhandler.NewWebHandler(mockClient, logger)
+
+	jsonBody, err := json.Marshal(webhandler.AddRequest{
+		Numbers:        []float64{1, math.NaN(), math.Inf(-1)},
+		NaNPolicy:      "propagate",
+		InfinityPolicy: "skip",
+	})
+	require.NoError(t, err)
+	assert.Contains(t, string(jsonBody), `"numbers":[1,"NaN","-Infinity"]`)
+
+	req := httptest.NewRequest(http.MethodPost, "/add", bytes.NewBuffer(jsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.AddHandler(w, req)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), `"result":"NaN"`)
+
+	var addResp webhandler.AddResponse
+	require.NoError(t, json.Unmarshal(body, &addResp))
+	assert.True(t, math.IsNaN(addResp.Result))
+	assert.Equal(t, "test-request-id", addResp.RequestID)
+	mockClient.AssertExpectations(t)
+}
+
+func TestAddHandler_CollectAllViolations(t *testing.T) {
+	maxValue := 100.0
+	mockClient := new(MockAdditionServiceClient)
+	mockClient.On("Add", mock.Anything, &v1.AddRequest{
+		Numbers: []float64{150, 5, 200},
+		Constraints: &v1.AddRequest_Constraints{
+			MaxValue:       &maxValue,
+			ValidationMode: v1.ValidationMode_VALIDATION_MODE_COLLECT_ALL,
+		},
+	}, mock.Anything).Return((*v1.AddResponse)(nil), grpcError(t, "constraint violations", &v1.AddResponse{
+		RequestId: "test-request-id",
+		Error: &v1.AddResponse_ErrorInfo{
+			Code:     "CONSTRAINT_VIOLATIONS",
+			Message:  "Found 2 constraint violations",
+			Severity: v1.AddResponse_ErrorInfo_SEVERITY_ERROR,
+			Violations: []*v1.AddResponse_ErrorInfo_Violation{
+				{Index: 0, Value: 150, Rule: "max_value", Code: "VALUE_TOO_HIGH", Message: "Number 150.000000 is above maximum 100.000000"},
+				{Index: 2, Value: 200, Rule: "max_value", Code: "VALUE_TOO_HIGH", Message: "Number 200.000000 is above maximum 100.000000"},
+			},
+		},
+	}))
+
+	logger := logging.NewLogger(logging.LogConfig{
+		ServiceName: "web-handler",
+		Debug:       true,
+		WriteToFile: true,
+	})
+	handler := webhandler.NewWebHandler(mockClient, logger)
+
+	jsonBody, err := json.Marshal(webhandler.AddRequest{
+		Numbers:        []float64{150, 5, 200},
+		MaxValue:       &maxValue,
+		ValidationMode: "collect_all",
+	})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/add", bytes.NewBuffer(jsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.AddHandler(w, req)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+
+	var addResp webhandler.AddResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&addResp))
+	require.NotNil(t, addResp.Error)
+	assert.Equal(t, "CONSTRAINT_VIOLATIONS", addResp.Error.Code)
+	require.Len(t, addResp.Error.Violations, 2)
+	assert.Equal(t, int32(2), addResp.Error.Violations[1].Index)
+	assert.Equal(t, webhandler.Float(200), addResp.Error.Violations[1].Value)
+	assert.Equal(t, "max_value", addResp.Error.Violations[1].Rule)
+	mockClient.AssertExpectations(t)
+}
+
+func TestAddHandler_RicherConstraints(t *testing.T) {
+	minSum := 0.0
+	maxSum := 100.0
+	mockClient := new(MockAdditionServiceClient)
+	mockClient.On("Add", mock.Anything, &v1.AddRequest{
+		Numbers: []float64{1, 2, 3},
+		Constraints: &v1.AddRequest_Constraints{
+			MinSum:           &minSum,
+			MaxSum:           &maxSum,
+			IntegerOnly:      true,
+			MaxDecimalPlaces: int32Ptr(2),
+			FiniteOnly:       true,
+			NonNegative:      true,
+			MinNumbers:       int32Ptr(2),
+			Unique:           true,
+		},
+	}, mock.Anything).Return(&v1.AddResponse{
+		Result:    6,
+		RequestId: "test-request-id",
+	}, nil)
+
+	logger := logging.NewLogger(logging.LogConfig{
+		ServiceName: "web-handler",
+		Debug:       true,
+		WriteToFile: true,
+	})
+	handler := webhandler.NewWebHandler(mockClient, logger)
+
+	jsonBody, err := json.Marshal(webhandler.AddRequest{
+		Numbers:          []float64{1, 2, 3},
+		MinSum:           &minSum,
+		MaxSum:           &maxSum,
+		IntegerOnly:      true,
+		MaxDecimalPlaces: int32Ptr(2),
+		FiniteOnly:       true,
+		NonNegative:      true,
+		MinNumbers:       int32Ptr(2),
+		Unique:           true,
+	})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/add", bytes.NewBuffer(jsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.AddHandler(w, req)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var addResp webhandler.AddResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&addResp))
+	assert.Equal(t, 6.0, addResp.Result)
+	mockClient.AssertExpectations(t)
+}
+
+func TestAddHandler_ConstraintActions(t *testing.T) {
+	maxValue := 10.0
+	minSum := 100.0
+	mockClient := new(MockAdditionServiceClient)
+	mockClient.On("Add", mock.Anything, &v1.AddRequest{
+		Numbers: []float64{5, 50, -1},
+		Constraints: &v1.AddRequest_Constraints{
+			MaxValue:          &maxValue,
+			NonNegative:       true,
+			MinSum:            &minSum,
+			MaxValueAction:    v1.ConstraintAction_CONSTRAINT_ACTION_CLAMP,
+			NonNegativeAction: v1.ConstraintAction_CONSTRAINT_ACTION_DROP,
+			UniqueAction:      -1,
+			MinSumAction:      v1.ConstraintAction_CONSTRAINT_ACTION_WARN,
+		},
+	}, mock.Anything).Return(&v1.AddResponse{
+		Result:    15,
+		RequestId: "test-request-id",
+		CalculationMetadata: &v1.AddResponse_CalculationMetadata{
+			CalculationTime:   timestamppb.Now(),
+			NumbersProcessed:  2,
+			CalculationMethod: "simple_addition",
+			ClampedCount:      1,
+			DroppedCount:      1,
+			Warnings: []*v1.AddResponse_ErrorInfo{{
+				Code:     "SUM_TOO_LOW",
+				Message:  "Sum 15.000000 is below minimum 100.000000",
+				Severity: v1.AddResponse_ErrorInfo_SEVERITY_WARNING,
+			}},
+		},
+	}, nil)
+
+	logger := logging.NewLogger(logging.LogConfig{
+		ServiceName: "web-handler",
+		Debug:       true,
+		WriteToFile: true,
+	})
+	handler := webhandler.NewWebHandler(mockClient, logger)
+
+	jsonBody, err := json.Marshal(webhandler.AddRequest{
+		Numbers:     []float64{5, 50, -1},
+		MaxValue:    &maxValue,
+		NonNegative: true,
+		MinSum:      &minSum,
+		Actions: &webhandler.ConstraintActions{
+			MaxValue:    "clamp",
+			NonNegative: "drop",
+			Unique:      "ignore",
+			MinSum:      "warn",
+		},
+	})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/add", bytes.NewBuffer(jsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.AddHandler(w, req)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var addResp webhandler.AddResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&addResp))
+	require.NotNil(t, addResp.CalculationMetadata)
+	assert.Equal(t, int32(1), addResp.CalculationMetadata.ClampedCount)
+	assert.Equal(t, int32(1), addResp.CalculationMetadata.DroppedCount)
+	require.Len(t, addResp.CalculationMetadata.Warnings, 1)
+	assert.Equal(t, "SUM_TOO_LOW", addResp.CalculationMetadata.Warnings[0].Code)
+	assert.Equal(t, "SEVERITY_WARNING", addResp.CalculationMetadata.Warnings[0].Severity)
+	mockClient.AssertExpectations(t)
+}
+
+func TestAddHandler_RetryWithRequestID(t *testing.T) {
+	mockClient := new(MockAdditionServiceClient)
+	mockClient.On("Add", mock.Anything, &v1.AddRequest{
+		RequestId: "client-request-id",
+		Numbers:   []float64{1, 2},
+	}, mock.Anything).Return(&v1.AddResponse{
+		RequestId: "client-request-id",
+		Error: &v1.AddResponse_ErrorInfo{
+			Code:     "IDEMPOTENCY_CONFLICT",
+			Message:  "Request ID client-request-id was already used for a different request",
+			Severity: v1.AddResponse_ErrorInfo_SEVERITY_ERROR,
+		},
+	}, nil)
+
+	logger := logging.NewLogger(logging.LogConfig{
+		ServiceName: "web-handler",
+		Debug:       true,
+		WriteToFile: true,
+	})
+	handler := webhandler.NewWebHandler(mockClient, logger)
+
+	jsonBody, err := json.Marshal(webhandler.AddRequest{
+		RequestID: "client-request-id",
+		Numbers:   []float64{1, 2},
+	})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/add", bytes.NewBuffer(jsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.AddHandler(w, req)
+
+	var addResp webhandler.AddResponse
+	require.NoError(t, json.NewDecoder(w.Result().Body).Decode(&addResp))
+	require.NotNil(t, addResp.Error)
+	assert.Equal(t, "IDEMPOTENCY_CONFLICT", addResp.Error.Code)
+	assert.Equal(t, "client-request-id", addResp.RequestID)
+	mockClient.AssertExpectations(t)
+}
+
+func TestArithmeticHandlers_ForwardRequestID(t *testing.T) {
+	metadata := &v1.AddResponse_CalculationMetadata{CalculationTime: timestamppb.Now()}
+	mockClient := new(MockAdditionServiceClient)
+	mockClient.On("Subtract", mock.Anything, &v1.SubtractRequest{
+		RequestId: "client-request-id",
+		Numbers:   []float64{5, 2},
+	}, mock.Anything).Return(&v1.SubtractResponse{Result: 3, RequestId: "client-request-id", CalculationMetadata: metadata}, nil)
+	mockClient.On("Multiply", mock.Anything, &v1.MultiplyRequest{
+		RequestId: "client-request-id",
+		Numbers:   []float64{5, 2},
+	}, mock.Anything).Return(&v1.MultiplyResponse{Result: 10, RequestId: "client-request-id", CalculationMetadata: metadata}, nil)
+	mockClient.On("Divide", mock.Anything, &v1.DivideRequest{
+		RequestId: "client-request-id",
+		Numbers:   []float64{5, 2},
+	}, mock.Anything).Return(&v1.DivideResponse{Result: 2.5, RequestId: "client-request-id", CalculationMetadata: metadata}, nil)
+	mockClient.On("Describe", mock.Anything, &v1.DescribeRequest{
+		RequestId: "client-request-id",
+		Numbers:   []float64{5, 2},
+	}, mock.Anything).Return(&v1.DescribeResponse{Sum: 7, RequestId: "client-request-id", CalculationMetadata: metadata}, nil)
+
+	logger := logging.NewLogger(logging.LogConfig{
+		ServiceName: "web-handler",
+		Debug:       true,
+		WriteToFile: true,
+	})
+	handler := webhandler.NewWebHandler(mockClient, logger)
+
+	testCases := []struct {
+		name   string
+		handle http.HandlerFunc
+	}{
+		{name: "subtract", handle: handler.SubtractHandler},
+		{name: "multiply", handle: handler.MultiplyHandler},
+		{name: "divide", handle: handler.DivideHandler},
+		{name: "describe", handle: handler.DescribeHandler},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			jsonBody, err := json.Marshal(webhandler.AddRequest{
+				RequestID: "client-request-id",
+				Numbers:   []float64{5, 2},
+			})
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/"+tc.name, bytes.NewBuffer(jsonBody))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			tc.handle(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			var resp struct {
+				RequestID string `json:"request_id"`
+			}
+			require.NoError(t, json.NewDecoder(w.Result().Body).Decode(&resp))
+			assert.Equal(t, "client-request-id", resp.RequestID)
+		})
+	}
+	mockClient.AssertExpectations(t)
+}
+
+func TestListCalculationsHandler(t *testing.T) {
+	start := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	mockClient := new(MockAdditionServiceClient)
+	mockClient.On("ListCalculations", mock.Anything, &v1.ListCalculationsRequest{
+		StartTime:       timestamppb.New(start),
+		ErrorCode:       "VALUE_TOO_HIGH",
+		RequestIdPrefix: "customer-",
+		PageSize:        10,
+		PageToken:       "page-2",
+	}, mock.Anything).Return(&v1.ListCalculationsResponse{
+		Calculations: []*v1.Calculation{
+			{
+				RequestId:       "customer-1",
+				Operation:       "add",
+				Request:         `{"numbers":[150]}`,
+				Response:        `{"requestId":"customer-1","error":{"code":"VALUE_TOO_HIGH"}}`,
+				ErrorCode:       "VALUE_TOO_HIGH",
+				CalculationTime: timestamppb.New(start),
+				Duration:        durationpb.New(1500 * time.Microsecond),
+			},
+		},
+		NextPageToken: "page-3",
+	}, nil)
+
+	logger := logging.NewLogger(logging.LogConfig{
+		ServiceName: "web-handler",
+		Debug:       true,
+		WriteToFile: true,
+	})
+	handler := webhandler.NewWebHandler(mockClient, logger)
+
+	req := httptest.NewRequest(http.MethodGet,
+		"/calculations?start_time=2026-01-02T03:04:05Z&error_code=VALUE_TOO_HIGH&request_id_prefix=customer-&page_size=10&page_token=page-2", nil)
+	w := httptest.NewRecorder()
+
+	handler.ListCalculationsHandler(w, req)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var listResp webhandler.ListCalculationsResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&listResp))
+	require.Len(t, listResp.Calculations, 1)
+	assert.Equal(t, "customer-1", listResp.Calculations[0].RequestID)
+	assert.Equal(t, "VALUE_TOO_HIGH", listResp.Calculations[0].ErrorCode)
+	assert.JSONEq(t, `{"numbers":[150]}`, string(listResp.Calculations[0].Request))
+	assert.Equal(t, "2026-01-02T03:04:05Z", listResp.Calculations[0].CalculationTime)
+	assert.Equal(t, 1.5, listResp.Calculations[0].DurationMs)
+	assert.Equal(t, "page-3", listResp.NextPageToken)
+	mockClient.AssertExpectations(t)
+}
+
+func TestListCalculationsHandler_InvalidQuery(t *testing.T) {
+	mockClient := new(MockAdditionServiceClient)
+
+	logger := logging.NewLogger(logging.LogConfig{
+		ServiceName: "web-handler",
+		Debug:       true,
+		WriteToFile: true,
+	})
+	handler := webhandler.NewWebHandler(mockClient, logger)
+
+	for _, query := range []string{"start_time=yesterday", "page_size=ten"} {
+		req := httptest.NewRequest(http.MethodGet, "/calculations?"+query, nil)
+		w := httptest.NewRecorder()
+
+		handler.ListCalculationsHandler(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Result().StatusCode, query)
+	}
+	mockClient.AssertNotCalled(t, "ListCalculations", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetCalculationHandler(t *testing.T) {
+	mockClient := new(MockAdditionServiceClient)
+	mockClient.On("GetCalculation", mock.Anything, &v1.GetCalculationRequest{
+		RequestId: "known-request-id",
+	}, mock.Anything).Return(&v1.GetCalculationResponse{
+		Calculation: &v1.Calculation{
+			RequestId:         "known-request-id",
+			Operation:         "add",
+			Request:           `{"numbers":[1,2]}`,
+			Response:          `{"result":3}`,
+			CalculationMethod: "simple_addition",
+			CalculationTime:   timestamppb.Now(),
+			Duration:          durationpb.New(time.Millisecond),
+			NumbersProcessed:  2,
+		},
+	}, nil)
+	mockClient.On("GetCalculation", mock.Anything, &v1.GetCalculationRequest{
+		RequestId: "unknown-request-id",
+	}, mock.Anything).Return(&v1.GetCalculationResponse{
+		Error: &v1.AddResponse_ErrorInfo{
+			Code:     "CALCULATION_NOT_FOUND",
+			Message:  "No calculation recorded for request ID unknown-request-id",
+			Severity: v1.AddResponse_ErrorInfo_SEVERITY_ERROR,
+		},
+	}, nil)
+
+	logger := logging.NewLogger(logging.LogConfig{
+		ServiceName: "web-handler",
+		Debug:       true,
+		WriteToFile: true,
+	})
+	handler := webhandler.NewWebHandler(mockClient, logger)
+
+	req := httptest.NewRequest(http.MethodGet, "/calculations/known-request-id", nil)
+	req.SetPathValue("request_id", "known-request-id")
+	w := httptest.NewRecorder()
+	handler.GetCalculationHandler(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Result().StatusCode)
+	var getResp webhandler.GetCalculationResponse
+	require.NoError(t, json.NewDecoder(w.Result().Body).Decode(&getResp))
+	require.NotNil(t, getResp.Calculation)
+	assert.Equal(t, "simple_addition", getResp.Calculation.CalculationMethod)
+	assert.JSONEq(t, `{"result":3}`, string(getResp.Calculation.Response))
+	assert.Equal(t, int32(2), getResp.Calculation.NumbersProcessed)
+	assert.False(t, getResp.Calculation.Truncated)
+
+	req = httptest.NewRequest(http.MethodGet, "/calculations/unknown-request-id", nil)
+	req.SetPathValue("request_id", "unknown-request-id")
+	w = httptest.NewRecorder()
+	handler.GetCalculationHandler(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Result().StatusCode)
+	getResp = webhandler.GetCalculationResponse{}
+	require.NoError(t, json.NewDecoder(w.Result().Body).Decode(&getResp))
+	require.NotNil(t, getResp.Error)
+	assert.Equal(t, "CALCULATION_NOT_FOUND", getResp.Error.Code)
+	mockClient.AssertExpectations(t)
+}
+
+func TestDivideHandler(t *testing.T) {
+	testCases := []struct {
+		name            string
+		requestBody     webhandler.AddRequest
+		mockServiceResp *v1.DivideResponse
+		expectedStatus  int
+	}{
+		{
+			name: "Successful Division",
+			requestBody: webhandler.AddRequest{
+				Numbers: []float64{12.0, 3.0, 2.0},
+			},
+			mockServiceResp: &v1.DivideResponse{
+				Result:    2.0,
+				RequestId: "test-request-id",
+				CalculationMetadata: &v1.AddResponse_CalculationMetadata{
+					CalculationTime:   timestamppb.New(time.Now()),
+					NumbersProcessed:  3,
+					CalculationMethod: "simple_division",
+				},
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Division By Zero",
+			requestBody: webhandler.AddRequest{
+				Numbers: []float64{1.0, 0.0},
+			},
+			mockServiceResp: &v1.DivideResponse{
+				Error: &v1.AddResponse_ErrorInfo{
+					Code:     "DIVISION_BY_ZERO",
+					Message:  "Divisor at position 1 is zero",
+					Severity: v1.AddResponse_ErrorInfo_SEVERITY_ERROR,
+				},
+				RequestId: "error-request-id",
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockClient := new(MockAdditionServiceClient)
+			mockClient.On("Divide", mock.Anything, mock.Anything, mock.Anything).Return(tc.mockServiceResp, nil)
+
+			logger := logging.NewLogger(logging.LogConfig{
+				ServiceName: "web-handler",
+				Debug:       true,
+				WriteToFile: true,
+			})
+			handler := webhandler.NewWebHandler(mockClient, logger)
+
+			jsonBody, err := json.Marshal(tc.requestBody)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/divide", bytes.NewBuffer(jsonBody))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			handler.DivideHandler(w, req)
+
+			resp := w.Result()
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+
+			var divideResp webhandler.AddResponse
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&divideResp))
+			assert.Equal(t, tc.mockServiceResp.RequestId, divideResp.RequestID)
+
+			if tc.expectedStatus == http.StatusOK {
+				assert.Equal(t, tc.mockServiceResp.Result, divideResp.Result)
+				require.NotNil(t, divideResp.CalculationMetadata)
+				assert.Equal(t, "simple_division", divideResp.CalculationMetadata.CalculationMethod)
+			} else {
+				require.NotNil(t, divideResp.Error)
+				assert.Equal(t, tc.mockServiceResp.Error.Code, divideResp.Error.Code)
+			}
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestEvaluateHandler(t *testing.T) {
+	testCases := []struct {
+		name            string
+		requestBody     webhandler.EvaluateRequest
+		mockServiceResp *v1.EvaluateResponse
+		expectedStatus  int
+	}{
+		{
+			name: "Successful Evaluation",
+			requestBody: webhandler.EvaluateRequest{
+				Expression: "(1.5 + 2) * 3 - 4 / 2",
+			},
+			mockServiceResp: &v1.EvaluateResponse{
+				Result:    8.5,
+				RequestId: "test-request-id",
+				CalculationMetadata: &v1.AddResponse_CalculationMetadata{
+					CalculationTime:   timestamppb.New(time.Now()),
+					NumbersProcessed:  5,
+					CalculationMethod: "expression_evaluation",
+				},
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Parse Error",
+			requestBody: webhandler.EvaluateRequest{
+				Expression: "1 + ",
+			},
+			mockServiceResp: &v1.EvaluateResponse{
+				Error: &v1.AddResponse_ErrorInfo{
+					Code:     "PARSE_ERROR",
+					Message:  "unexpected end of expression",
+					Severity: v1.AddResponse_ErrorInfo_SEVERITY_ERROR,
+					Position: int32Ptr(4),
+				},
+				ParseErrors: []*v1.AddResponse_ErrorInfo{
+					{
+						Code:     "PARSE_ERROR",
+						Message:  "unexpected end of expression",
+						Severity: v1.AddResponse_ErrorInfo_SEVERITY_ERROR,
+						Position: int32Ptr(4),
+					},
+				},
+				RequestId: "error-request-id",
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockClient := new(MockAdditionServiceClient)
+			// Failed calls reach the client as a status carrying the response
+			mockResp, mockErr := tc.mockServiceResp, error(nil)
+			if tc.mockServiceResp.Error != nil {
+				mockResp, mockErr = nil, grpcError(t, "invalid expression", tc.mockServiceResp)
+			}
+			mockClient.On("Evaluate", mock.Anything, &v1.EvaluateRequest{Expression: tc.requestBody.Expression}, mock.Anything).Return(mockResp, mockErr)
+
+			logger := logging.NewLogger(logging.LogConfig{
+				ServiceName: "web-handler",
+				Debug:       true,
+				WriteToFile: true,
+			})
+			handler := webhandler.NewWebHandler(mockClient, logger)
+
+			jsonBody, err := json.Marshal(tc.requestBody)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/evaluate", bytes.NewBuffer(jsonBody))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			handler.EvaluateHandler(w, req)
+
+			resp := w.Result()
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+
+			var evaluateResp webhandler.EvaluateResponse
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&evaluateResp))
+			assert.Equal(t, tc.mockServiceResp.RequestId, evaluateResp.RequestID)
+
+			if tc.expectedStatus == http.StatusOK {
+				assert.Equal(t, tc.mockServiceResp.Result, evaluateResp.Result)
+				require.NotNil(t, evaluateResp.CalculationMetadata)
+				assert.Equal(t, int32(5), evaluateResp.CalculationMetadata.NumbersProcessed)
+			} else {
+				require.NotNil(t, evaluateResp.Error)
+				assert.Equal(t, "PARSE_ERROR", evaluateResp.Error.Code)
+				require.NotNil(t, evaluateResp.Error.Position)
+				assert.Equal(t, int32(4), *evaluateResp.Error.Position)
+				assert.Len(t, evaluateResp.ParseErrors, 1)
+			}
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDescribeHandler(t *testing.T) {
+	mockClient := new(MockAdditionServiceClient)
+	mockClient.On("Describe", mock.Anything, &v1.DescribeRequest{
+		Numbers:     []float64{1.0, 2.0, 3.0, 4.0},
+		Constraints: &v1.AddRequest_Constraints{MaxNumbers: int32Ptr(10)},
+		Percentiles: []float64{90},
+	}, mock.Anything).Return(&v1.DescribeResponse{
+		RequestId:         "test-request-id",
+		Sum:               10.0,
+		Mean:              2.5,
+		Variance:          1.25,
+		StandardDeviation: 1.118,
+		Min:               1.0,
+		Max:               4.0,
+		Median:            2.5,
+		Percentiles: []*v1.DescribeResponse_Percentile{
+			{Percentile: 90, Value: 3.7},
+		},
+		CalculationMetadata: &v1.AddResponse_CalculationMetadata{
+			CalculationTime:   timestamppb.New(time.Now()),
+			NumbersProcessed:  4,
+			CalculationMethod: "descriptive_statistics",
+		},
+	}, nil)
+
+	logger := logging.NewLogger(logging.LogConfig{
+		ServiceName: "web-handler",
+		Debug:       true,
+		WriteToFile: true,
+	})
+	handler := webhandler.NewWebHandler(mockClient, logger)
+
+	jsonBody := []byte(`{"numbers": [1.0, 2.0, 3.0, 4.0], "max_numbers": 10, "percentiles": [90]}`)
+	req := httptest.NewRequest(http.MethodPost, "/describe", bytes.NewBuffer(jsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.DescribeHandler(w, req)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var describeResp webhandler.DescribeResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&describeResp))
+	assert.Equal(t, "test-request-id", describeResp.RequestID)
+	assert.Equal(t, webhandler.Float(2.5), describeResp.Mean)
+	assert.Equal(t, webhandler.Float(2.5), describeResp.Median)
+	assert.Equal(t, webhandler.Float(4), describeResp.Max)
+	require.Len(t, describeResp.Percentiles, 1)
+	assert.Equal(t, webhandler.Float(3.7), describeResp.Percentiles[0].Value)
+	require.NotNil(t, describeResp.CalculationMetadata)
+	assert.Equal(t, "descriptive_statistics", describeResp.CalculationMetadata.CalculationMethod)
+	mockClient.AssertExpectations(t)
+}
+
+func TestDescribeHandler_NonFiniteValues(t *testing.T) {
+	mockClient := new(MockAdditionServiceClient)
+	mockClient.On("Describe", mock.Anything, mock.MatchedBy(func(req *v1.DescribeRequest) bool {
+		return len(req.Numbers) == 2 &&
+			math.IsInf(req.Numbers[1], 1) &&
+			req.Constraints.GetInfinityPolicy() == v1.NonFinitePolicy_NON_FINITE_POLICY_PROPAGATE
+	}), mock.Anything).Return(&v1.DescribeResponse{
+		RequestId:         "test-request-id",
+		Sum:               math.Inf(1),
+		Mean:              math.Inf(1),
+		Variance:          math.NaN(),
+		StandardDeviation: math.NaN(),
+		Min:               1.0,
+		Max:               math.Inf(1),
+		Median:            math.Inf(1),
+	}, nil)
+
+	logger := logging.NewLogger(logging.LogConfig{
+		ServiceName: "web-handler",
+		Debug:       true,
+		WriteToFile: true,
+	})
+	handler := webhandler.NewWebHandler(mockClient, logger)
+
+	jsonBody := []byte(`{"numbers": [1, "Infinity"], "infinity_policy": "propagate"}`)
+	req := httptest.NewRequest(http.MethodPost, "/describe", bytes.NewBuffer(jsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.DescribeHandler(w, req)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), `"sum":"Infinity"`)
+	assert.Contains(t, string(body), `"variance":"NaN"`)
+
+	var describeResp webhandler.DescribeResponse
+	require.NoError(t, json.Unmarshal(body, &describeResp))
+	assert.True(t, math.IsInf(float64(describeResp.Max), 1))
+	assert.True(t, math.IsNaN(float64(describeResp.StandardDeviation)))
+	mockClient.AssertExpectations(t)
+}
+
+func TestBatchAddHandler(t *testing.T) {
+	mockClient := new(MockAdditionServiceClient)
+	mockClient.On("BatchAdd", mock.Anything, &v1.BatchAddRequest{
+		Requests: []*v1.AddRequest{
+			{Numbers: []float64{1.0, 2.0}},
+			{Numbers: []float64{}},
+		},
+	}, mock.Anything).Return(&v1.BatchAddResponse{
+		RequestId: "batch-request-id",
+		Responses: []*v1.AddResponse{
+			{
+				Result:    3.0,
+				RequestId: "item-1",
+				CalculationMetadata: &v1.AddResponse_CalculationMetadata{
+					CalculationTime:   timestamppb.New(time.Now()),
+					NumbersProcessed:  2,
+					CalculationMethod: "simple_addition",
+				},
+			},
+			{
+				RequestId: "item-2",
+				Error: &v1.AddResponse_ErrorInfo{
+					Code:     "NO_NUMBERS",
+					Message:  "No numbers provided for calculation",
+					Severity: v1.AddResponse_ErrorInfo_SEVERITY_WARNING,
+				},
+			},
+		},
+		FailedCount: 1,
+	}, nil)
+
+	logger := logging.NewLogger(logging.LogConfig{
+		ServiceName: "web-handler",
+		Debug:       true,
+		WriteToFile: true,
+	})
+	handler := webhandler.NewWebHandler(mockClient, logger)
+
+	jsonBody, err := json.Marshal(webhandler.BatchAddRequest{
+		Requests: []webhandler.AddRequest{
+			{Numbers: []float64{1.0, 2.0}},
+			{Numbers: []float64{}},
+		},
+	})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/add/batch", bytes.NewBuffer(jsonBody))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+
+	handler.BatchAddHandler(w, req)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var batchResp webhandler.BatchAddResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&batchResp))
+	assert.Equal(t, "batch-request-id", batchResp.RequestID)
+	assert.Equal(t, int32(1), batchResp.FailedCount)
+	require.Len(t, batchResp.Responses, 2)
+
+	assert.Equal(t, 3.0, batchResp.Responses[0].Result)
+	assert.Nil(t, batchResp.Responses[0].Error)
+	require.NotNil(t, batchResp.Responses[0].CalculationMetadata)
+
+	require.NotNil(t, batchResp.Responses[1].Error)
+	assert.Equal(t, "NO_NUMBERS", batchResp.Responses[1].Error.Code)
+	mockClient.AssertExpectations(t)
+}
+
 // Helper functions for creating pointers
 func int32Ptr(i int32) *int32 {
 	return &i